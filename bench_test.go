@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/twharmon/slices"
@@ -44,6 +45,147 @@ func BenchmarkSort(b *testing.B) {
 	})
 }
 
+func BenchmarkSortStableFunc(b *testing.B) {
+	b.Run("std lib", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(unsortedStringSlice)
+			sort.SliceStable(s, func(i, j int) bool {
+				return s[i] < s[j]
+			})
+		}
+	})
+	b.Run("slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortStableFunc(unsortedStringSlice, func(a string, b string) bool {
+				return a < b
+			})
+		}
+	})
+}
+
+func BenchmarkSortStable(b *testing.B) {
+	b.Run("std lib", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(unsortedStringSlice)
+			sort.Stable(sort.StringSlice(s))
+		}
+	})
+	b.Run("slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortStable(unsortedStringSlice)
+		}
+	})
+}
+
+func BenchmarkSortBy(b *testing.B) {
+	b.Run("sort func", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortFunc(unsortedStringSlice, func(a string, b string) bool {
+				return strings.ToLower(a) < strings.ToLower(b)
+			})
+		}
+	})
+	b.Run("sort by", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortBy(unsortedStringSlice, strings.ToLower)
+		}
+	})
+}
+
+func BenchmarkSortStrings(b *testing.B) {
+	paths := make([]string, 100000)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("/api/v1/users/%d/posts/%d", rand.Intn(1000), rand.Intn(1000))
+	}
+	b.Run("std lib", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(paths)
+			sort.Strings(s)
+		}
+	})
+	b.Run("slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.Sort(paths)
+		}
+	})
+}
+
+func BenchmarkSortNumbers(b *testing.B) {
+	ints := make([]int64, 1000000)
+	floats := make([]float64, len(ints))
+	for i := range ints {
+		ints[i] = rand.Int63()
+		floats[i] = rand.NormFloat64()
+	}
+	b.Run("int64/std lib", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(ints)
+			sort.Slice(s, func(i, j int) bool {
+				return s[i] < s[j]
+			})
+		}
+	})
+	b.Run("int64/slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.Sort(ints)
+		}
+	})
+	b.Run("float64/std lib", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			s := slices.Clone(floats)
+			sort.Float64s(s)
+		}
+	})
+	b.Run("float64/slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.Sort(floats)
+		}
+	})
+}
+
+func BenchmarkSortAdversarial(b *testing.B) {
+	const n = 100000
+	patterns := []struct {
+		name string
+		gen  func(i int) int
+	}{
+		{"sorted", func(i int) int { return i }},
+		{"reversed", func(i int) int { return n - i }},
+		{"equal", func(i int) int { return 0 }},
+		{"sawtooth", func(i int) int { return i % 1000 }},
+		{"organ pipe", func(i int) int {
+			if i < n/2 {
+				return i
+			}
+			return n - i
+		}},
+	}
+	for _, p := range patterns {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = p.gen(i)
+		}
+		b.Run(p.name+"/std lib", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c := slices.Clone(s)
+				sort.Ints(c)
+			}
+		})
+		b.Run(p.name+"/slices", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Sort(s)
+			}
+		})
+		b.Run(p.name+"/slices func", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.SortFunc(s, func(a, b int) bool {
+					return a < b
+				})
+			}
+		})
+	}
+}
+
 func BenchmarkReverse(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_ = slices.Reverse(unsortedStringSlice)
@@ -126,3 +268,83 @@ func BenchmarkIntersection(b *testing.B) {
 	run(20, 2000)
 	run(2000, 20)
 }
+
+func BenchmarkSetOperationsSorted(b *testing.B) {
+	makeSortedSlice := func(size int, options int) []int {
+		s := make([]int, size)
+		for i := range s {
+			s[i] = rand.Intn(options + 1)
+		}
+		return slices.Sort(s)
+	}
+	run := func(name string, s ...[]int) {
+		b.Run(name+"/union", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Union(s...)
+			}
+		})
+		b.Run(name+"/union sorted", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.UnionSorted(s...)
+			}
+		})
+		b.Run(name+"/intersection", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Intersection(s...)
+			}
+		})
+		b.Run(name+"/intersection sorted", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.IntersectionSorted(s...)
+			}
+		})
+		b.Run(name+"/difference", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Difference(s...)
+			}
+		})
+		b.Run(name+"/difference sorted", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.DifferenceSorted(s...)
+			}
+		})
+	}
+	run("2000x20", func() [][]int {
+		s := make([][]int, 20)
+		for i := range s {
+			s[i] = makeSortedSlice(2000, 2000)
+		}
+		return s
+	}()...)
+	run("skewed", makeSortedSlice(100000, 1000000), makeSortedSlice(10, 1000000))
+}
+
+func BenchmarkParallelSort(b *testing.B) {
+	s := make([]int, 1000000)
+	for i := range s {
+		s[i] = rand.Int()
+	}
+	less := func(a, b int) bool {
+		return a < b
+	}
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortFunc(s, less)
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.ParallelSortFunc(s, less, slices.ParallelOptions{})
+		}
+	})
+	b.Run("serial stable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.SortStableFunc(s, less)
+		}
+	})
+	b.Run("parallel stable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = slices.ParallelSortStableFunc(s, less, slices.ParallelOptions{})
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package slices
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// checkLessSamples is the number of triples of items that CheckLess
+// samples. Slices with fewer triples are checked exhaustively.
+const checkLessSamples = 1024
+
+// CheckLess checks if the given less func is a strict weak ordering of
+// the items of the given slice, as the sorts and the other funcs that
+// take a less func require, and returns an error naming the offending
+// items if it is not. It checks that no item is less than itself, that
+// no two items are less than each other, and that both being less and
+// being incomparable are transitive. Every item is checked against
+// itself, but only a sample of the triples of items is checked in
+// long slices, so a nil error does not prove the less func correct.
+//
+// Building with the slicesdebug tag makes the funcs of this package
+// that take a less func panic with the error of CheckLess.
+func CheckLess[T any](s []T, less func(a, b T) bool) error {
+	for i := range s {
+		if less(s[i], s[i]) {
+			return fmt.Errorf("slices: less is not irreflexive: less(s[%d], s[%d]) is true for %v", i, i, s[i])
+		}
+	}
+	n := len(s)
+	if n < 2 {
+		return nil
+	}
+	if n*n <= checkLessSamples/n {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				for k := 0; k < n; k++ {
+					if err := checkTriple(s, i, j, k, less); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	r := rand.New(rand.NewSource(1))
+	for t := 0; t < checkLessSamples; t++ {
+		if err := checkTriple(s, r.Intn(n), r.Intn(n), r.Intn(n), less); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTriple checks the items of s at the indices i, j and k like
+// CheckLess does.
+func checkTriple[T any](s []T, i, j, k int, less func(a, b T) bool) error {
+	a, b, c := s[i], s[j], s[k]
+	ab, ba := less(a, b), less(b, a)
+	if ab && ba {
+		return fmt.Errorf("slices: less is not asymmetric: less(s[%d], s[%d]) and less(s[%d], s[%d]) are both true for %v and %v", i, j, j, i, a, b)
+	}
+	bc, cb := less(b, c), less(c, b)
+	ac, ca := less(a, c), less(c, a)
+	if ab && bc && !ac {
+		return fmt.Errorf("slices: less is not transitive: less(s[%d], s[%d]) and less(s[%d], s[%d]) are true but less(s[%d], s[%d]) is false for %v, %v and %v", i, j, j, k, i, k, a, b, c)
+	}
+	if !ab && !ba && !bc && !cb && (ac || ca) {
+		return fmt.Errorf("slices: incomparability is not transitive: s[%d] and s[%d] and s[%d] and s[%d] are incomparable but s[%d] and s[%d] are not for %v, %v and %v", i, j, j, k, i, k, a, b, c)
+	}
+	return nil
+}
+
+// mustCheckLess panics with the error of CheckLess, if any.
+func mustCheckLess[T any](s []T, less func(a, b T) bool) {
+	if err := CheckLess(s, less); err != nil {
+		panic(err)
+	}
+}
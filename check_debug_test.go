@@ -0,0 +1,38 @@
+//go:build slicesdebug
+
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func assertLessPanics(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		err, ok := recover().(error)
+		assertEqual(t, true, ok)
+		assertErrorContains(t, err, "less is not irreflexive")
+	}()
+	f()
+}
+
+func TestDebugCheckLess(t *testing.T) {
+	s := []int{3, 1, 2}
+	lessEqual := func(a, b int) bool { return a <= b }
+	assertLessPanics(t, func() { slices.SortFunc(s, lessEqual) })
+	assertLessPanics(t, func() { slices.SortStableFunc(s, lessEqual) })
+	assertLessPanics(t, func() { slices.MinFunc(s, lessEqual) })
+	assertLessPanics(t, func() { slices.MaxFunc(s, lessEqual) })
+	assertLessPanics(t, func() { slices.TopKFunc(s, 2, lessEqual) })
+	assertLessPanics(t, func() { slices.SelectFunc(s, 1, lessEqual) })
+	assertLessPanics(t, func() { slices.ArgSortFunc(s, lessEqual) })
+	assertLessPanics(t, func() { slices.BinarySearchFunc(s, 2, lessEqual) })
+	assertLessPanics(t, func() { slices.NewSortedSliceFunc(lessEqual, s...) })
+	assertLessPanics(t, func() { slices.ParallelSortFunc(s, lessEqual, slices.ParallelOptions{}) })
+	assertLessPanics(t, func() { slices.SortCmp(s, func(a, b int) int { return -1 }) })
+	assertLessPanics(t, func() {
+		slices.ExternalSortFunc(slices.SliceIterator(s), lessEqual, slices.Codec[int](slices.JSONCodec[int]{}), slices.ExternalOptions{})
+	})
+}
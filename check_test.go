@@ -0,0 +1,48 @@
+package slices_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func assertErrorContains(t *testing.T, err error, want string) {
+	t.Helper()
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("want error containing %q; got %v", want, err)
+	}
+}
+
+func TestCheckLess(t *testing.T) {
+	s := sortPatterns(1000)["random"]
+	assertEqual(t, nil, slices.CheckLess(s, func(a, b int) bool { return a < b }))
+	assertEqual(t, nil, slices.CheckLess(people, slices.By(func(p person) int { return p.age })))
+	assertEqual(t, nil, slices.CheckLess([]int{}, func(a, b int) bool { return true }))
+}
+
+func TestCheckLessIrreflexive(t *testing.T) {
+	err := slices.CheckLess([]int{3, 1, 2}, func(a, b int) bool { return a <= b })
+	assertEqual(t, "slices: less is not irreflexive: less(s[0], s[0]) is true for 3", err.Error())
+}
+
+func TestCheckLessAsymmetric(t *testing.T) {
+	err := slices.CheckLess([]int{1, 2}, func(a, b int) bool { return a != b })
+	assertErrorContains(t, err, "less is not asymmetric: less(s[0], s[1]) and less(s[1], s[0]) are both true for 1 and 2")
+}
+
+func TestCheckLessTransitive(t *testing.T) {
+	// Rock, paper, scissors.
+	beats := map[string]string{"rock": "scissors", "scissors": "paper", "paper": "rock"}
+	err := slices.CheckLess([]string{"rock", "paper", "scissors"}, func(a, b string) bool { return beats[b] == a })
+	assertErrorContains(t, err, "less is not transitive")
+}
+
+func TestCheckLessIncomparability(t *testing.T) {
+	// Items that are close to each other compare as equal.
+	less := func(a, b int) bool { return b-a > 1 }
+	err := slices.CheckLess([]int{1, 2, 3}, less)
+	assertErrorContains(t, err, "incomparability is not transitive")
+	s := slices.Map(sortPatterns(1000)["random"], func(x int) int { return x % 10 })
+	assertErrorContains(t, slices.CheckLess(s, less), "incomparability is not transitive")
+}
@@ -0,0 +1,215 @@
+package slices
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringOrder determines how strings are compared by ByString.
+type StringOrder int
+
+const (
+	// CaseSensitive compares strings byte by byte.
+	CaseSensitive StringOrder = iota
+
+	// CaseInsensitive compares strings rune by rune after folding
+	// them to lower case.
+	CaseInsensitive
+)
+
+// Compare returns -1 if a is less than b, 0 if a equals b and +1 if a
+// is greater than b. Floating point values are compared in a total
+// order: a NaN is considered less than any other value and equal to
+// any other NaN, and -0 is considered less than +0.
+func Compare[T Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case b < a:
+		return 1
+	case a == b:
+		// Only zeros can be equal with different bits, and only the
+		// zeros of floating point types need telling apart.
+		var zero T
+		if a == zero && !sameBits(a, b) && isFloat[T]() {
+			if aNeg, bNeg := signbit(a), signbit(b); aNeg != bNeg {
+				if aNeg {
+					return -1
+				}
+				return 1
+			}
+		}
+		return 0
+	}
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return -1
+	}
+	return 1
+}
+
+// By returns a less func that orders items by the keys returned by
+// the given key func, compared like in Compare.
+func By[T any, K Ordered](key func(item T) K) func(a, b T) bool {
+	return func(a, b T) bool {
+		return Compare(key(a), key(b)) < 0
+	}
+}
+
+// ByString returns a less func that orders items by the strings
+// returned by the given key func, compared according to the given
+// order.
+func ByString[T any](key func(item T) string, order StringOrder) func(a, b T) bool {
+	if order == CaseInsensitive {
+		return func(a, b T) bool {
+			return compareFold(key(a), key(b)) < 0
+		}
+	}
+	return By(key)
+}
+
+// ThenBy returns a less func that orders items by the given less func
+// and items that are equal according to it by the keys returned by
+// the given key func.
+func ThenBy[T any, K Ordered](less func(a, b T) bool, key func(item T) K) func(a, b T) bool {
+	return Chain(less, By(key))
+}
+
+// Chain returns a less func that orders items by the first of the
+// given less funcs that tells them apart.
+func Chain[T any](less ...func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		for i := range less {
+			if less[i](a, b) {
+				return true
+			}
+			if less[i](b, a) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+// Descending returns a less func that reverses the order of the given
+// less func.
+func Descending[T any](less func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
+
+// NilsFirst returns a less func for pointers that orders nil before
+// any other pointer and compares the values of non nil pointers with
+// the given less func.
+func NilsFirst[T any](less func(a, b T) bool) func(a, b *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return less(*a, *b)
+	}
+}
+
+// NilsLast returns a less func for pointers that orders nil after any
+// other pointer and compares the values of non nil pointers with the
+// given less func.
+func NilsLast[T any](less func(a, b T) bool) func(a, b *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return less(*a, *b)
+	}
+}
+
+// CompareBy returns a compare func that orders items by the keys
+// returned by the given key func.
+func CompareBy[T any, K Ordered](key func(item T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return Compare(key(a), key(b))
+	}
+}
+
+// ChainCompare returns a compare func that orders items by the first
+// of the given compare funcs that tells them apart.
+func ChainCompare[T any](cmp ...func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for i := range cmp {
+			if c := cmp[i](a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// DescendingCompare returns a compare func that reverses the order of
+// the given compare func.
+func DescendingCompare[T any](cmp func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
+
+// LessFromCmp returns a less func that reports whether a is less than
+// b according to the given compare func.
+func LessFromCmp[T any](cmp func(a, b T) int) func(a, b T) bool {
+	return func(a, b T) bool {
+		return cmp(a, b) < 0
+	}
+}
+
+// CmpFromLess returns a compare func that orders items according to
+// the given less func.
+func CmpFromLess[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	}
+}
+
+// compareFold compares a and b rune by rune after folding them to
+// lower case.
+func compareFold(a, b string) int {
+	for a != "" && b != "" {
+		var ra, rb rune
+		if a[0] < utf8.RuneSelf {
+			ra, a = rune(a[0]), a[1:]
+		} else {
+			r, size := utf8.DecodeRuneInString(a)
+			ra, a = r, a[size:]
+		}
+		if b[0] < utf8.RuneSelf {
+			rb, b = rune(b[0]), b[1:]
+		} else {
+			r, size := utf8.DecodeRuneInString(b)
+			rb, b = r, b[size:]
+		}
+		if ra == rb {
+			continue
+		}
+		ra, rb = unicode.ToLower(ra), unicode.ToLower(rb)
+		if ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	}
+	return 1
+}
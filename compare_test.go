@@ -0,0 +1,165 @@
+package slices_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+var people = []person{
+	{"bob", 30},
+	{"Alice", 25},
+	{"carol", 30},
+	{"alice", 40},
+	{"Dave", 25},
+}
+
+func TestCompare(t *testing.T) {
+	assertEqual(t, -1, slices.Compare(1, 2))
+	assertEqual(t, 0, slices.Compare("a", "a"))
+	assertEqual(t, 1, slices.Compare(2.5, 1.5))
+	assertEqual(t, -1, slices.Compare(math.NaN(), math.Inf(-1)))
+	assertEqual(t, 1, slices.Compare(math.Inf(-1), math.NaN()))
+	assertEqual(t, 0, slices.Compare(math.NaN(), math.NaN()))
+	assertEqual(t, -1, slices.Compare(math.Copysign(0, -1), 0))
+	assertEqual(t, 1, slices.Compare(float32(0), float32(math.Copysign(0, -1))))
+	assertEqual(t, 0, slices.Compare(0, 0))
+}
+
+func TestBy(t *testing.T) {
+	got := slices.SortStableFunc(people, slices.By(func(p person) int { return p.age }))
+	want := []person{{"Alice", 25}, {"Dave", 25}, {"bob", 30}, {"carol", 30}, {"alice", 40}}
+	assertEqual(t, want, got)
+}
+
+func TestByFloatKeys(t *testing.T) {
+	id := func(f float64) float64 { return f }
+	less := slices.By(id)
+	assertEqual(t, true, less(math.NaN(), 1))
+	assertEqual(t, false, less(1, math.NaN()))
+	assertEqual(t, true, less(math.Copysign(0, -1), 0))
+	lessCmp := slices.LessFromCmp(slices.CompareBy(id))
+	for _, a := range []float64{math.NaN(), math.Copysign(0, -1), 0, 1} {
+		for _, b := range []float64{math.NaN(), math.Copysign(0, -1), 0, 1} {
+			assertEqual(t, lessCmp(a, b), less(a, b))
+		}
+	}
+}
+
+func TestByString(t *testing.T) {
+	name := func(p person) string { return p.name }
+	t.Run("case sensitive", func(t *testing.T) {
+		got := slices.SortStableFunc(people, slices.ByString(name, slices.CaseSensitive))
+		want := []string{"Alice", "Dave", "alice", "bob", "carol"}
+		assertEqual(t, want, slices.Map(got, name))
+	})
+	t.Run("case insensitive", func(t *testing.T) {
+		got := slices.SortStableFunc(people, slices.ByString(name, slices.CaseInsensitive))
+		want := []string{"Alice", "alice", "bob", "carol", "Dave"}
+		assertEqual(t, want, slices.Map(got, name))
+	})
+	t.Run("prefix", func(t *testing.T) {
+		less := slices.ByString(func(s string) string { return s }, slices.CaseInsensitive)
+		assertEqual(t, true, less("AB", "abc"))
+		assertEqual(t, false, less("abc", "AB"))
+		assertEqual(t, false, less("Ä", "ä"))
+	})
+}
+
+func TestThenBy(t *testing.T) {
+	less := slices.ThenBy(slices.By(func(p person) int { return p.age }), func(p person) string { return p.name })
+	got := slices.SortFunc(people, less)
+	want := []person{{"Alice", 25}, {"Dave", 25}, {"bob", 30}, {"carol", 30}, {"alice", 40}}
+	assertEqual(t, want, got)
+}
+
+func TestChain(t *testing.T) {
+	less := slices.Chain(
+		slices.Descending(slices.By(func(p person) int { return p.age })),
+		slices.ByString(func(p person) string { return p.name }, slices.CaseInsensitive),
+	)
+	t.Run("sort", func(t *testing.T) {
+		got := slices.SortFunc(people, less)
+		want := []person{{"alice", 40}, {"bob", 30}, {"carol", 30}, {"Alice", 25}, {"Dave", 25}}
+		assertEqual(t, want, got)
+	})
+	t.Run("min", func(t *testing.T) {
+		assertEqual(t, person{"alice", 40}, slices.MinFunc(people, less))
+	})
+	t.Run("max", func(t *testing.T) {
+		assertEqual(t, person{"Dave", 25}, slices.MaxFunc(people, less))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assertEqual(t, false, slices.Chain[int]()(1, 2))
+	})
+}
+
+func TestNilsFirst(t *testing.T) {
+	one, two := 1, 2
+	s := []*int{&two, nil, &one}
+	got := slices.SortFunc(s, slices.NilsFirst(func(a, b int) bool { return a < b }))
+	assertEqual(t, []*int{nil, &one, &two}, got)
+}
+
+func TestNilsLast(t *testing.T) {
+	one, two := 1, 2
+	s := []*int{&two, nil, &one}
+	got := slices.SortFunc(s, slices.NilsLast(func(a, b int) bool { return a < b }))
+	assertEqual(t, []*int{&one, &two, nil}, got)
+}
+
+func TestChainCompare(t *testing.T) {
+	cmp := slices.ChainCompare(
+		slices.CompareBy(func(p person) int { return p.age }),
+		slices.DescendingCompare(slices.CompareBy(func(p person) string { return p.name })),
+	)
+	assertEqual(t, -1, cmp(person{"Dave", 25}, person{"Alice", 25}))
+	assertEqual(t, 1, cmp(person{"Alice", 25}, person{"Dave", 25}))
+	assertEqual(t, -1, cmp(person{"bob", 30}, person{"alice", 40}))
+	assertEqual(t, 0, cmp(person{"bob", 30}, person{"bob", 30}))
+}
+
+func TestLessFromCmp(t *testing.T) {
+	less := slices.LessFromCmp(slices.Compare[int])
+	assertEqual(t, true, less(1, 2))
+	assertEqual(t, false, less(2, 2))
+	assertEqual(t, false, less(3, 2))
+}
+
+func TestCmpFromLess(t *testing.T) {
+	cmp := slices.CmpFromLess(func(a, b int) bool { return a < b })
+	assertEqual(t, -1, cmp(1, 2))
+	assertEqual(t, 0, cmp(2, 2))
+	assertEqual(t, 1, cmp(3, 2))
+}
+
+func TestSortCmp(t *testing.T) {
+	cmp := slices.CompareBy(func(p person) int { return p.age })
+	got := slices.SortCmp(people, slices.ChainCompare(cmp, slices.CompareBy(func(p person) string { return p.name })))
+	want := []person{{"Alice", 25}, {"Dave", 25}, {"bob", 30}, {"carol", 30}, {"alice", 40}}
+	assertEqual(t, want, got)
+}
+
+func TestMaxCmp(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		assertEqual(t, 6, slices.MaxCmp([]int{2, 6, 1, 4, 3}, slices.Compare[int]))
+	})
+	t.Run("zero value", func(t *testing.T) {
+		assertEqual(t, 0, slices.MaxCmp([]int{}, slices.Compare[int]))
+	})
+}
+
+func TestMinCmp(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		assertEqual(t, 1, slices.MinCmp([]int{2, 6, 1, 4, 3}, slices.Compare[int]))
+	})
+	t.Run("zero value", func(t *testing.T) {
+		assertEqual(t, 0, slices.MinCmp([]int{}, slices.Compare[int]))
+	})
+}
@@ -0,0 +1,6 @@
+//go:build slicesdebug
+
+package slices
+
+// debug makes the funcs that take a less func check it with CheckLess.
+const debug = true
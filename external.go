@@ -0,0 +1,400 @@
+package slices
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Default limits of a run of the external sort.
+const (
+	defaultMaxRunItems = 1 << 20
+	defaultMaxRunBytes = 64 << 20
+)
+
+// Iterator iterates over a sequence of items. Next advances to the
+// next item and reports whether there is one, Value returns the
+// current item and Err returns the error that ended the iteration
+// early, if any.
+type Iterator[T any] interface {
+	Next() bool
+	Value() T
+	Err() error
+}
+
+// Codec encodes items to bytes and decodes them again. It is used by
+// the external sorts to write items to disk.
+type Codec[T any] interface {
+	Encode(item T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// JSONCodec is a Codec that encodes items as JSON.
+type JSONCodec[T any] struct{}
+
+// Encode encodes the given item as JSON.
+func (JSONCodec[T]) Encode(item T) ([]byte, error) {
+	return json.Marshal(item)
+}
+
+// Decode decodes an item from the given JSON.
+func (JSONCodec[T]) Decode(data []byte) (T, error) {
+	var t T
+	err := json.Unmarshal(data, &t)
+	return t, err
+}
+
+// ExternalOptions configures the external sorts. The zero value
+// writes temporary files to the default directory for temporary files
+// and uses the default run limits.
+type ExternalOptions struct {
+	// Dir is the directory in which a temporary directory for the
+	// sorted runs is created. It defaults to os.TempDir().
+	Dir string
+
+	// MaxRunItems is the greatest number of items held in memory
+	// before they are sorted and written to disk as a run. It
+	// defaults to 1048576.
+	MaxRunItems int
+
+	// MaxRunBytes is the greatest total encoded size of the items
+	// held in memory before they are sorted and written to disk as a
+	// run. It defaults to 64 MiB.
+	MaxRunBytes int
+}
+
+// SliceIterator returns an iterator over the items of the given slice.
+func SliceIterator[T any](s []T) Iterator[T] {
+	return &sliceIterator[T]{s: s, i: -1}
+}
+
+type sliceIterator[T any] struct {
+	s []T
+	i int
+}
+
+func (it *sliceIterator[T]) Next() bool {
+	if it.i+1 >= len(it.s) {
+		it.i = len(it.s)
+		return false
+	}
+	it.i++
+	return true
+}
+
+func (it *sliceIterator[T]) Value() T {
+	return it.s[it.i]
+}
+
+func (it *sliceIterator[T]) Err() error {
+	return nil
+}
+
+// Collect creates a new slice that contains the remaining items of the
+// given iterator and returns it, along with the error that ended the
+// iteration early, if any.
+func Collect[T any](it Iterator[T]) ([]T, error) {
+	s := make([]T, 0)
+	for it.Next() {
+		s = append(s, it.Value())
+	}
+	return s, it.Err()
+}
+
+// ExternalSort sorts the items of the given iterator in ascending
+// order like SortStable, but holds at most one run of items in memory
+// at a time. See ExternalSortFunc.
+func ExternalSort[T Ordered](it Iterator[T], codec Codec[T], opts ExternalOptions) (*ExternalIterator[T], error) {
+	return ExternalSortFunc(it, ascending[T](), codec, opts)
+}
+
+// ExternalSortFunc sorts the items of the given iterator in ascending
+// order according to the given less func and returns an iterator over
+// the sorted items. Items that are equal keep their original order.
+// The items are read in runs limited by the given options; each run is
+// sorted in memory and, unless it is the only one, encoded with the
+// given codec and written to a temporary directory. The runs are then
+// merged while the returned iterator is advanced. The temporary
+// directory is removed when an error occurs, when the returned
+// iterator is exhausted or when it is closed.
+func ExternalSortFunc[T any](it Iterator[T], less func(a, b T) bool, codec Codec[T], opts ExternalOptions) (*ExternalIterator[T], error) {
+	maxItems, maxBytes := opts.MaxRunItems, opts.MaxRunBytes
+	if maxItems <= 0 {
+		maxItems = defaultMaxRunItems
+	}
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxRunBytes
+	}
+	e := &ExternalIterator[T]{less: less, codec: codec}
+	lessRecord := func(a, b encodedItem[T]) bool {
+		return less(a.item, b.item)
+	}
+	var run []encodedItem[T]
+	size := 0
+	for it.Next() {
+		item := it.Value()
+		data, err := codec.Encode(item)
+		if err != nil {
+			e.Close()
+			return nil, err
+		}
+		run = append(run, encodedItem[T]{item: item, data: data})
+		size += len(data)
+		if len(run) >= maxItems || size >= maxBytes {
+			if debug {
+				mustCheckLess(Map(run, encodedItem[T].value), less)
+			}
+			mergeSortFunc(run, lessRecord)
+			if err := e.spill(opts.Dir, run); err != nil {
+				e.Close()
+				return nil, err
+			}
+			run, size = run[:0], 0
+		}
+	}
+	if err := it.Err(); err != nil {
+		e.Close()
+		return nil, err
+	}
+	if debug {
+		mustCheckLess(Map(run, encodedItem[T].value), less)
+	}
+	mergeSortFunc(run, lessRecord)
+	if len(e.paths) == 0 {
+		e.mem = run
+		return e, nil
+	}
+	if len(run) > 0 {
+		if err := e.spill(opts.Dir, run); err != nil {
+			e.Close()
+			return nil, err
+		}
+	}
+	if err := e.open(); err != nil {
+		e.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// encodedItem pairs an item with its encoding.
+type encodedItem[T any] struct {
+	item T
+	data []byte
+}
+
+func (e encodedItem[T]) value() T {
+	return e.item
+}
+
+// ExternalIterator iterates over the items sorted by an external sort.
+// It must be closed if it is not iterated to the end.
+type ExternalIterator[T any] struct {
+	less  func(a, b T) bool
+	codec Codec[T]
+	dir   string
+	paths []string
+	mem   []encodedItem[T]
+	runs  []*runReader
+	heap  []runHead[T]
+	cur   T
+	err   error
+}
+
+// runReader reads the records of a run from a file.
+type runReader struct {
+	f *os.File
+	r *bufio.Reader
+}
+
+// runHead is the next item of a run.
+type runHead[T any] struct {
+	item T
+	run  int
+}
+
+// Next advances the iterator to the next item and reports whether
+// there is one.
+func (e *ExternalIterator[T]) Next() bool {
+	if e.err != nil {
+		return false
+	}
+	if e.runs == nil {
+		if len(e.mem) == 0 {
+			e.mem = nil
+			return false
+		}
+		e.cur = e.mem[0].item
+		e.mem = e.mem[1:]
+		return true
+	}
+	if len(e.heap) == 0 {
+		e.err = e.Close()
+		return false
+	}
+	top := e.heap[0]
+	e.cur = top.item
+	item, ok, err := e.read(top.run)
+	if err != nil {
+		e.err = err
+		e.Close()
+		return false
+	}
+	if ok {
+		e.heap[0].item = item
+	} else {
+		e.heap[0] = e.heap[len(e.heap)-1]
+		e.heap = e.heap[:len(e.heap)-1]
+	}
+	e.down(0)
+	return true
+}
+
+// Value returns the current item.
+func (e *ExternalIterator[T]) Value() T {
+	return e.cur
+}
+
+// Err returns the error that ended the iteration early, if any.
+func (e *ExternalIterator[T]) Err() error {
+	return e.err
+}
+
+// Close closes the files of the sorted runs and removes the temporary
+// directory they were written to.
+func (e *ExternalIterator[T]) Close() error {
+	var err error
+	for _, r := range e.runs {
+		if cerr := r.f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	e.runs = nil
+	e.heap = nil
+	e.mem = nil
+	if e.dir != "" {
+		if rerr := os.RemoveAll(e.dir); rerr != nil && err == nil {
+			err = rerr
+		}
+		e.dir = ""
+	}
+	return err
+}
+
+// spill writes the given sorted run to a new file in the temporary
+// directory, creating the directory first if needed.
+func (e *ExternalIterator[T]) spill(parent string, run []encodedItem[T]) error {
+	if e.dir == "" {
+		dir, err := os.MkdirTemp(parent, "slices-sort-")
+		if err != nil {
+			return err
+		}
+		e.dir = dir
+	}
+	path := filepath.Join(e.dir, fmt.Sprintf("run-%d", len(e.paths)))
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	e.paths = append(e.paths, path)
+	w := bufio.NewWriter(f)
+	var n [binary.MaxVarintLen64]byte
+	for i := range run {
+		if _, err := w.Write(n[:binary.PutUvarint(n[:], uint64(len(run[i].data)))]); err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := w.Write(run[i].data); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// open opens the files of the sorted runs and reads the first item of
+// each into the heap.
+func (e *ExternalIterator[T]) open() error {
+	e.runs = make([]*runReader, 0, len(e.paths))
+	for _, path := range e.paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		e.runs = append(e.runs, &runReader{f: f, r: bufio.NewReader(f)})
+	}
+	for i := range e.runs {
+		item, ok, err := e.read(i)
+		if err != nil {
+			return err
+		}
+		if ok {
+			e.heap = append(e.heap, runHead[T]{item: item, run: i})
+		}
+	}
+	for i := len(e.heap)/2 - 1; i >= 0; i-- {
+		e.down(i)
+	}
+	return nil
+}
+
+// read reads the next item of the given run and reports whether there
+// was one.
+func (e *ExternalIterator[T]) read(run int) (T, bool, error) {
+	var t T
+	r := e.runs[run].r
+	n, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return t, false, nil
+	}
+	if err != nil {
+		return t, false, err
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return t, false, err
+	}
+	t, err = e.codec.Decode(data)
+	if err != nil {
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+// lessHead orders the heads of the runs by their items and equal items
+// by their runs, which keeps the merge stable.
+func (e *ExternalIterator[T]) lessHead(a, b runHead[T]) bool {
+	if e.less(a.item, b.item) {
+		return true
+	}
+	if e.less(b.item, a.item) {
+		return false
+	}
+	return a.run < b.run
+}
+
+func (e *ExternalIterator[T]) down(i int) {
+	h := e.heap
+	for {
+		child := 2*i + 1
+		if child >= len(h) {
+			return
+		}
+		if child+1 < len(h) && e.lessHead(h[child+1], h[child]) {
+			child++
+		}
+		if !e.lessHead(h[child], h[i]) {
+			return
+		}
+		h[i], h[child] = h[child], h[i]
+		i = child
+	}
+}
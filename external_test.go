@@ -0,0 +1,146 @@
+package slices_test
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+type failingIterator struct {
+	slices.Iterator[int]
+	err error
+}
+
+func (it *failingIterator) Err() error {
+	return it.err
+}
+
+type intCodec struct {
+	failEncode int
+	failDecode int
+}
+
+func (c *intCodec) Encode(item int) ([]byte, error) {
+	if item == c.failEncode {
+		return nil, errors.New("encode failed")
+	}
+	return []byte(strconv.Itoa(item)), nil
+}
+
+func (c *intCodec) Decode(data []byte) (int, error) {
+	item, err := strconv.Atoi(string(data))
+	if err == nil && item == c.failDecode {
+		return 0, errors.New("decode failed")
+	}
+	return item, err
+}
+
+func assertEmptyDir(t *testing.T, dir string) {
+	entries, err := os.ReadDir(dir)
+	assertEqual(t, nil, err)
+	assertEqual(t, 0, len(entries))
+}
+
+func TestExternalSort(t *testing.T) {
+	s := make([]int, 1000)
+	for i := range s {
+		s[i] = rand.Intn(100) - 50
+	}
+	codec := &intCodec{failEncode: -1000, failDecode: -1000}
+	t.Run("in memory", func(t *testing.T) {
+		dir := t.TempDir()
+		it, err := slices.ExternalSort[int](slices.SliceIterator(s), codec, slices.ExternalOptions{Dir: dir})
+		assertEqual(t, nil, err)
+		got, err := slices.Collect[int](it)
+		assertEqual(t, nil, err)
+		assertEqual(t, slices.Sort(s), got)
+		assertEmptyDir(t, dir)
+	})
+	t.Run("spilled", func(t *testing.T) {
+		dir := t.TempDir()
+		it, err := slices.ExternalSort[int](slices.SliceIterator(s), codec, slices.ExternalOptions{Dir: dir, MaxRunItems: 64})
+		assertEqual(t, nil, err)
+		got, err := slices.Collect[int](it)
+		assertEqual(t, nil, err)
+		assertEqual(t, slices.Sort(s), got)
+		assertEmptyDir(t, dir)
+	})
+	t.Run("run bytes", func(t *testing.T) {
+		dir := t.TempDir()
+		it, err := slices.ExternalSort[int](slices.SliceIterator(s), codec, slices.ExternalOptions{Dir: dir, MaxRunBytes: 100})
+		assertEqual(t, nil, err)
+		got, err := slices.Collect[int](it)
+		assertEqual(t, nil, err)
+		assertEqual(t, slices.Sort(s), got)
+	})
+	t.Run("empty", func(t *testing.T) {
+		it, err := slices.ExternalSort[int](slices.SliceIterator([]int{}), codec, slices.ExternalOptions{})
+		assertEqual(t, nil, err)
+		got, err := slices.Collect[int](it)
+		assertEqual(t, nil, err)
+		assertEqual(t, []int{}, got)
+	})
+	t.Run("close early", func(t *testing.T) {
+		dir := t.TempDir()
+		it, err := slices.ExternalSort[int](slices.SliceIterator(s), codec, slices.ExternalOptions{Dir: dir, MaxRunItems: 64})
+		assertEqual(t, nil, err)
+		assertEqual(t, true, it.Next())
+		assertEqual(t, slices.Min(s), it.Value())
+		assertEqual(t, nil, it.Close())
+		assertEmptyDir(t, dir)
+		assertEqual(t, false, it.Next())
+	})
+}
+
+func TestExternalSortFunc(t *testing.T) {
+	s := make([]record, 1000)
+	for i := range s {
+		s[i] = record{id: rand.Intn(20), name: strconv.Itoa(i)}
+	}
+	type jsonRecord struct {
+		ID   int
+		Name string
+	}
+	r := slices.Map(s, func(r record) jsonRecord { return jsonRecord{r.id, r.name} })
+	less := slices.By(func(r jsonRecord) int { return r.ID })
+	var codec slices.Codec[jsonRecord] = slices.JSONCodec[jsonRecord]{}
+	it, err := slices.ExternalSortFunc(slices.SliceIterator(r), less, codec, slices.ExternalOptions{MaxRunItems: 100})
+	assertEqual(t, nil, err)
+	got, err := slices.Collect[jsonRecord](it)
+	assertEqual(t, nil, err)
+	assertEqual(t, slices.SortStableFunc(r, less), got)
+}
+
+func TestExternalSortErrors(t *testing.T) {
+	s := []int{5, 3, 8, 1, 9, 2, 7}
+	t.Run("iterator", func(t *testing.T) {
+		dir := t.TempDir()
+		it := &failingIterator{Iterator: slices.SliceIterator(s), err: errors.New("read failed")}
+		_, err := slices.ExternalSort[int](it, &intCodec{}, slices.ExternalOptions{Dir: dir, MaxRunItems: 2})
+		assertEqual(t, "read failed", err.Error())
+		assertEmptyDir(t, dir)
+	})
+	t.Run("encode", func(t *testing.T) {
+		dir := t.TempDir()
+		_, err := slices.ExternalSort[int](slices.SliceIterator(s), &intCodec{failEncode: 9}, slices.ExternalOptions{Dir: dir, MaxRunItems: 2})
+		assertEqual(t, "encode failed", err.Error())
+		assertEmptyDir(t, dir)
+	})
+	t.Run("decode", func(t *testing.T) {
+		dir := t.TempDir()
+		it, err := slices.ExternalSort[int](slices.SliceIterator(s), &intCodec{failDecode: 8}, slices.ExternalOptions{Dir: dir, MaxRunItems: 2})
+		assertEqual(t, nil, err)
+		got, err := slices.Collect[int](it)
+		assertEqual(t, "decode failed", err.Error())
+		assertEqual(t, true, len(got) < len(s))
+		assertEmptyDir(t, dir)
+	})
+	t.Run("directory", func(t *testing.T) {
+		_, err := slices.ExternalSort[int](slices.SliceIterator(s), &intCodec{}, slices.ExternalOptions{Dir: "/does/not/exist", MaxRunItems: 2})
+		assertEqual(t, true, err != nil)
+	})
+}
@@ -0,0 +1,181 @@
+package slices
+
+import (
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// Float is a constraint that permits any floating point type.
+//
+// The sorts, searches and the Min and Max functions of this package
+// order floating point values in a total order, the one of Compare:
+// NaNs come before all other values and -0 comes before +0.
+type Float interface {
+	~float32 | ~float64
+}
+
+// ContainsNaN checks if any of the items in the given slice are NaN.
+func ContainsNaN[F Float](s []F) bool {
+	return IndexOfNaN(s) >= 0
+}
+
+// IndexOfNaN finds the index of the first item in the given slice that
+// is NaN. It returns -1 if there is none.
+func IndexOfNaN[F Float](s []F) int {
+	for i := range s {
+		if s[i] != s[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// WithoutNaN creates a new slice that contains the items of the given
+// slice that are not NaN and returns it. The given slice is not
+// changed.
+func WithoutNaN[F Float](s []F) []F {
+	return Filter(s, func(item F) bool {
+		return item == item
+	})
+}
+
+// FloatEqual checks if the given floats are equal. Unlike ==, it
+// considers a NaN equal to any other NaN.
+func FloatEqual[F Float](a, b F) bool {
+	return a == b || a != a && b != b
+}
+
+// FloatEqualEpsilon checks if the given floats differ by at most the
+// given epsilon. Infinities are only equal to themselves and a NaN is
+// only equal to other NaNs.
+func FloatEqualEpsilon[F Float](a, b, epsilon F) bool {
+	if a == b {
+		return true
+	}
+	if a != a || b != b {
+		return a != a && b != b
+	}
+	if math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0) {
+		return false
+	}
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d <= epsilon
+}
+
+// FloatEqualULP checks if the given floats are at most the given
+// number of units in the last place apart, that is if there are at
+// most ulps-1 representable floats between them. -0 and +0 are equal
+// and a NaN is only equal to other NaNs.
+func FloatEqualULP[F Float](a, b F, ulps uint64) bool {
+	if a == b {
+		return true
+	}
+	if a != a || b != b {
+		return a != a && b != b
+	}
+	ka, kb := orderedBits(a), orderedBits(b)
+	if ka > kb {
+		ka, kb = kb, ka
+	}
+	return kb-ka <= ulps
+}
+
+// orderedBits maps the given float, which must not be NaN, to an
+// integer such that neighbouring floats map to neighbouring integers
+// and both zeros map to the same one.
+func orderedBits[F Float](x F) uint64 {
+	var b, sign uint64
+	if unsafe.Sizeof(x) == 4 {
+		b, sign = uint64(math.Float32bits(float32(x))), 1<<31
+	} else {
+		b, sign = math.Float64bits(float64(x)), 1<<63
+	}
+	if b&sign != 0 {
+		return sign - b&^sign
+	}
+	return sign + b
+}
+
+// isFloat reports whether T is a floating point type.
+func isFloat[T any]() bool {
+	k := reflect.TypeOf((*T)(nil)).Elem().Kind()
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// sameBits reports whether a and b have the same bits. Only values
+// as wide as float32 or float64 are compared; others are reported to
+// have the same bits.
+func sameBits[T any](a, b T) bool {
+	switch unsafe.Sizeof(a) {
+	case 4:
+		return *(*uint32)(unsafe.Pointer(&a)) == *(*uint32)(unsafe.Pointer(&b))
+	case 8:
+		return *(*uint64)(unsafe.Pointer(&a)) == *(*uint64)(unsafe.Pointer(&b))
+	}
+	return true
+}
+
+// signbit reports whether x, whose type must be a floating point type,
+// is negative or negative zero.
+func signbit[T any](x T) bool {
+	if unsafe.Sizeof(x) == 4 {
+		return math.Signbit(float64(*(*float32)(unsafe.Pointer(&x))))
+	}
+	return math.Signbit(*(*float64)(unsafe.Pointer(&x)))
+}
+
+// sortFloats sorts s in place in the total order of Compare. If stable
+// is true, NaNs keep their original order.
+func sortFloats[F Float](s []F, stable bool) {
+	if stable {
+		s = s[moveNaNsFrontStable(s):]
+		mergeSort(s)
+	} else {
+		if len(s) >= radixThreshold {
+			radixSortFloat(s)
+			return
+		}
+		s = s[moveNaNsFront(s):]
+		if !presorted(s) {
+			quickSort(s, 0, len(s)-1, maxDepth(len(s)))
+		}
+	}
+	orderZeros(s)
+}
+
+// moveNaNsFrontStable moves the NaNs in s to the front and returns how
+// many there are. Both the NaNs and the other items keep their order.
+func moveNaNsFrontStable[F Float](s []F) int {
+	var nans []F
+	j := len(s)
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] != s[i] {
+			nans = append(nans, s[i])
+		} else {
+			j--
+			s[j] = s[i]
+		}
+	}
+	for i := range nans {
+		s[i] = nans[len(nans)-1-i]
+	}
+	return len(nans)
+}
+
+// orderZeros moves the negative zeros in s, which must be sorted and
+// free of NaNs, before the positive ones.
+func orderZeros[F Float](s []F) {
+	lo := lowerBound(s, 0)
+	hi := lo + upperBound(s[lo:], 0)
+	neg := lo
+	for i := lo; i < hi; i++ {
+		if math.Signbit(float64(s[i])) {
+			s[neg], s[i] = s[i], s[neg]
+			neg++
+		}
+	}
+}
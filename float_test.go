@@ -0,0 +1,145 @@
+package slices_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+var (
+	negZero  = math.Copysign(0, -1)
+	pointOne = 0.1
+)
+
+// assertTotalOrder fails the test unless s is sorted with NaNs first
+// and -0 before +0.
+func assertTotalOrder(t *testing.T, s []float64) {
+	t.Helper()
+	for i := 1; i < len(s); i++ {
+		if slices.Compare(s[i], s[i-1]) < 0 {
+			t.Fatalf("%v before %v at index %d", s[i-1], s[i], i)
+		}
+	}
+}
+
+func specialFloats(n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		switch rand.Intn(8) {
+		case 0:
+			s[i] = math.NaN()
+		case 1:
+			s[i] = 0
+		case 2:
+			s[i] = negZero
+		default:
+			s[i] = float64(rand.Intn(20) - 10)
+		}
+	}
+	return s
+}
+
+func TestSortFloatsTotalOrder(t *testing.T) {
+	for _, n := range []int{2, 10, 100, 1000} {
+		s := specialFloats(n)
+		assertTotalOrder(t, slices.Sort(s))
+		assertTotalOrder(t, slices.SortStable(s))
+		assertTotalOrder(t, slices.SortBy(s, func(f float64) float64 { return f }))
+		for _, opts := range parallelOptions {
+			assertTotalOrder(t, slices.ParallelSort(s, opts))
+			assertTotalOrder(t, slices.ParallelSortStable(s, opts))
+		}
+		assertTotalOrder(t, slices.PartialSort(s, n))
+		assertTotalOrder(t, slices.BottomK(s, n))
+		assertTotalOrder(t, slices.Reverse(slices.TopK(s, n)))
+	}
+}
+
+func TestSortFloatsPresorted(t *testing.T) {
+	got := slices.Sort([]float64{1, math.NaN(), 0})
+	assertEqual(t, true, math.IsNaN(got[0]))
+	assertEqual(t, []float64{0, 1}, got[1:])
+	got = slices.Sort([]float64{0, negZero})
+	assertEqual(t, true, math.Signbit(got[0]))
+	assertEqual(t, false, math.Signbit(got[1]))
+}
+
+func TestSortStableNaNs(t *testing.T) {
+	a := math.Float64frombits(0x7ff8000000000001)
+	b := math.Float64frombits(0x7ff8000000000002)
+	got := slices.SortStable([]float64{2, b, 1, a, b})
+	want := []uint64{0x7ff8000000000002, 0x7ff8000000000001, 0x7ff8000000000002}
+	assertEqual(t, want, slices.Map(got[:3], math.Float64bits))
+	assertEqual(t, []float64{1, 2}, got[3:])
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	s := []float64{math.NaN(), 3, 1, math.NaN(), 2}
+	assertEqual(t, 3.0, slices.Max(s))
+	assertEqual(t, true, math.IsNaN(slices.Min(s)))
+	assertEqual(t, true, math.IsNaN(slices.Max([]float64{math.NaN()})))
+	assertEqual(t, false, math.Signbit(slices.Max([]float64{negZero, 0})))
+	assertEqual(t, true, math.Signbit(slices.Min([]float64{0, negZero})))
+}
+
+func TestIndexOfNaN(t *testing.T) {
+	s := []float64{1, math.NaN(), 2}
+	assertEqual(t, 1, slices.IndexOf(s, math.NaN()))
+	assertEqual(t, true, slices.Contains(s, math.NaN()))
+	assertEqual(t, false, slices.Contains([]float64{1, 2}, math.NaN()))
+	assertEqual(t, 1, slices.IndexOfNaN(s))
+	assertEqual(t, -1, slices.IndexOfNaN([]float32{1, 2}))
+	assertEqual(t, true, slices.ContainsNaN(s))
+	assertEqual(t, false, slices.ContainsNaN([]float64{}))
+	assertEqual(t, []float64{1, 2}, slices.WithoutNaN(s))
+}
+
+func TestBinarySearchNaN(t *testing.T) {
+	s := slices.Sort([]float64{3, math.NaN(), 1, math.NaN(), 2, negZero})
+	i, ok := slices.BinarySearch(s, 2)
+	assertEqual(t, 4, i)
+	assertEqual(t, true, ok)
+	lo, hi := slices.EqualRange(s, math.NaN())
+	assertEqual(t, 0, lo)
+	assertEqual(t, 2, hi)
+	assertEqual(t, false, slices.ContainsSorted(s, 0))
+	assertEqual(t, true, slices.ContainsSorted(s, negZero))
+}
+
+func TestQuantileNaN(t *testing.T) {
+	assertEqual(t, true, math.IsNaN(slices.Median([]float64{1, math.NaN(), 3})))
+}
+
+func TestFloatEqual(t *testing.T) {
+	sum := pointOne + 0.2
+	assertEqual(t, true, slices.FloatEqual(math.NaN(), math.NaN()))
+	assertEqual(t, true, slices.FloatEqual(negZero, 0))
+	assertEqual(t, false, slices.FloatEqual(math.NaN(), 1))
+	assertEqual(t, false, slices.FloatEqual(sum, 0.3))
+}
+
+func TestFloatEqualEpsilon(t *testing.T) {
+	sum := pointOne + 0.2
+	assertEqual(t, true, slices.FloatEqualEpsilon(sum, 0.3, 1e-9))
+	assertEqual(t, false, slices.FloatEqualEpsilon(1.0, 1.1, 0.01))
+	assertEqual(t, true, slices.FloatEqualEpsilon(math.Inf(1), math.Inf(1), 0))
+	assertEqual(t, false, slices.FloatEqualEpsilon(math.Inf(1), math.MaxFloat64, math.Inf(1)))
+	assertEqual(t, true, slices.FloatEqualEpsilon(math.NaN(), math.NaN(), 0))
+	assertEqual(t, false, slices.FloatEqualEpsilon(math.NaN(), 1, math.Inf(1)))
+}
+
+func TestFloatEqualULP(t *testing.T) {
+	sum := pointOne + 0.2
+	assertEqual(t, true, slices.FloatEqualULP(sum, 0.3, 1))
+	assertEqual(t, false, slices.FloatEqualULP(sum, 0.3, 0))
+	assertEqual(t, true, slices.FloatEqualULP(negZero, 0, 0))
+	tiny := math.SmallestNonzeroFloat64
+	assertEqual(t, true, slices.FloatEqualULP(-tiny, tiny, 2))
+	assertEqual(t, false, slices.FloatEqualULP(-tiny, tiny, 1))
+	assertEqual(t, true, slices.FloatEqualULP(float32(1), math.Nextafter32(1, 2), 1))
+	assertEqual(t, false, slices.FloatEqualULP(float32(1), 1.5, 1000))
+	assertEqual(t, true, slices.FloatEqualULP(math.NaN(), math.NaN(), 0))
+	assertEqual(t, false, slices.FloatEqualULP(math.NaN(), 1, math.MaxUint64))
+}
@@ -0,0 +1,348 @@
+package slices
+
+// UnionSorted creates a new slice that contains the union of all the
+// given slices, which must be sorted in ascending order. The given
+// slices are not changed. The returned slice is sorted in ascending
+// order and all items in it are distinct. Floating point values are
+// compared in the total order of Compare.
+func UnionSorted[T Ordered](s ...[]T) []T {
+	if isFloat[T]() {
+		return unionSortedFunc(s, ascending[T]())
+	}
+	var h cursorHeap[T]
+	size := 0
+	for i := range s {
+		if len(s[i]) > 0 {
+			h = append(h, cursor[T]{head: s[i][0], slice: i})
+		}
+		if len(s[i]) > size {
+			size = len(s[i])
+		}
+	}
+	h.init()
+	pos := make([]int, len(s))
+	output := make([]T, 0, size)
+	for len(h) > 0 {
+		m := h[0].slice
+		end := len(s[m])
+		if len(h) > 1 {
+			// Everything in s[m] below the smallest head of the other
+			// slices can be copied over in one go.
+			bound := h[1].head
+			if len(h) > 2 && h[2].head < bound {
+				bound = h[2].head
+			}
+			end = gallop(s[m], pos[m], bound)
+			if end == pos[m] {
+				end++
+			}
+		}
+		output = appendDistinct(output, s[m][pos[m]:end])
+		pos[m] = end
+		if end == len(s[m]) {
+			h[0] = h[len(h)-1]
+			h = h[:len(h)-1]
+		} else {
+			h[0].head = s[m][end]
+		}
+		h.down(0)
+	}
+	return output
+}
+
+// IntersectionSorted creates a new slice that contains the
+// intersection of all the given slices, which must be sorted in
+// ascending order. The given slices are not changed. The returned
+// slice is sorted in ascending order and all items in it are
+// distinct. Floating point values are compared in the total order of
+// Compare.
+func IntersectionSorted[T Ordered](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	if isFloat[T]() {
+		return intersectionSortedFunc(s, ascending[T]())
+	}
+	d := 0
+	for i := range s {
+		if len(s[i]) < len(s[d]) {
+			d = i
+		}
+	}
+	pos := make([]int, len(s))
+	output := make([]T, 0, len(s[d]))
+	for i := 0; i < len(s[d]); {
+		x := s[d][i]
+		next := -1
+		for j := range s {
+			if j == d {
+				continue
+			}
+			pos[j] = gallop(s[j], pos[j], x)
+			if pos[j] == len(s[j]) {
+				return output
+			}
+			if x < s[j][pos[j]] {
+				next = j
+				break
+			}
+		}
+		if next >= 0 {
+			i = gallop(s[d], i+1, s[next][pos[next]])
+			continue
+		}
+		output = append(output, x)
+		for i < len(s[d]) && !(x < s[d][i]) {
+			i++
+		}
+	}
+	return output
+}
+
+// DifferenceSorted creates a new slice that contains the items of the
+// first slice that occur in none of the other given slices, which all
+// must be sorted in ascending order. The given slices are not changed.
+// The returned slice is sorted in ascending order and all items in it
+// are distinct. Floating point values are compared in the total order
+// of Compare.
+func DifferenceSorted[T Ordered](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	if isFloat[T]() {
+		return differenceSortedFunc(s, ascending[T]())
+	}
+	pos := make([]int, len(s))
+	output := make([]T, 0, len(s[0]))
+	for i := 0; i < len(s[0]); {
+		x := s[0][i]
+		found := false
+		for j := 1; j < len(s); j++ {
+			pos[j] = gallop(s[j], pos[j], x)
+			if pos[j] < len(s[j]) && !(x < s[j][pos[j]]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, x)
+		}
+		for i < len(s[0]) && !(x < s[0][i]) {
+			i++
+		}
+	}
+	return output
+}
+
+// gallop returns the index of the first item in the sorted slice s at
+// or after lo that is not less than x. It probes exponentially growing
+// steps before searching, so the cost is logarithmic in the distance
+// from lo rather than in the length of s.
+func gallop[T Ordered](s []T, lo int, x T) int {
+	if lo >= len(s) || !(s[lo] < x) {
+		return lo
+	}
+	prev, step := lo, 1
+	hi := lo + 1
+	for hi < len(s) && s[hi] < x {
+		prev = hi
+		step *= 2
+		hi = prev + step
+	}
+	if hi > len(s) {
+		hi = len(s)
+	}
+	return prev + 1 + lowerBound(s[prev+1:hi], x)
+}
+
+// appendDistinct appends the items of the sorted slice run to the
+// sorted slice s, skipping items equal to the last one appended.
+func appendDistinct[T Ordered](s []T, run []T) []T {
+	for _, x := range run {
+		if len(s) == 0 || s[len(s)-1] < x {
+			s = append(s, x)
+		}
+	}
+	return s
+}
+
+// cursor is the current head of one of the slices being merged.
+type cursor[T any] struct {
+	head  T
+	slice int
+}
+
+// cursorHeap is a min heap of cursors ordered by their heads.
+type cursorHeap[T Ordered] []cursor[T]
+
+func (h cursorHeap[T]) init() {
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+func (h cursorHeap[T]) down(i int) {
+	for {
+		child := 2*i + 1
+		if child >= len(h) {
+			return
+		}
+		if child+1 < len(h) && h[child+1].head < h[child].head {
+			child++
+		}
+		if !(h[child].head < h[i].head) {
+			return
+		}
+		h[i], h[child] = h[child], h[i]
+		i = child
+	}
+}
+
+func unionSortedFunc[T any](s [][]T, less func(T, T) bool) []T {
+	var h []cursor[T]
+	size := 0
+	for i := range s {
+		if len(s[i]) > 0 {
+			h = append(h, cursor[T]{head: s[i][0], slice: i})
+		}
+		if len(s[i]) > size {
+			size = len(s[i])
+		}
+	}
+	initCursorsFunc(h, less)
+	pos := make([]int, len(s))
+	output := make([]T, 0, size)
+	for len(h) > 0 {
+		m := h[0].slice
+		end := len(s[m])
+		if len(h) > 1 {
+			bound := h[1].head
+			if len(h) > 2 && less(h[2].head, bound) {
+				bound = h[2].head
+			}
+			end = gallopFunc(s[m], pos[m], bound, less)
+			if end == pos[m] {
+				end++
+			}
+		}
+		output = appendDistinctFunc(output, s[m][pos[m]:end], less)
+		pos[m] = end
+		if end == len(s[m]) {
+			h[0] = h[len(h)-1]
+			h = h[:len(h)-1]
+		} else {
+			h[0].head = s[m][end]
+		}
+		downCursorsFunc(h, 0, less)
+	}
+	return output
+}
+
+func intersectionSortedFunc[T any](s [][]T, less func(T, T) bool) []T {
+	d := 0
+	for i := range s {
+		if len(s[i]) < len(s[d]) {
+			d = i
+		}
+	}
+	pos := make([]int, len(s))
+	output := make([]T, 0, len(s[d]))
+	for i := 0; i < len(s[d]); {
+		x := s[d][i]
+		next := -1
+		for j := range s {
+			if j == d {
+				continue
+			}
+			pos[j] = gallopFunc(s[j], pos[j], x, less)
+			if pos[j] == len(s[j]) {
+				return output
+			}
+			if less(x, s[j][pos[j]]) {
+				next = j
+				break
+			}
+		}
+		if next >= 0 {
+			i = gallopFunc(s[d], i+1, s[next][pos[next]], less)
+			continue
+		}
+		output = append(output, x)
+		for i < len(s[d]) && !less(x, s[d][i]) {
+			i++
+		}
+	}
+	return output
+}
+
+func differenceSortedFunc[T any](s [][]T, less func(T, T) bool) []T {
+	pos := make([]int, len(s))
+	output := make([]T, 0, len(s[0]))
+	for i := 0; i < len(s[0]); {
+		x := s[0][i]
+		found := false
+		for j := 1; j < len(s); j++ {
+			pos[j] = gallopFunc(s[j], pos[j], x, less)
+			if pos[j] < len(s[j]) && !less(x, s[j][pos[j]]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, x)
+		}
+		for i < len(s[0]) && !less(x, s[0][i]) {
+			i++
+		}
+	}
+	return output
+}
+
+func gallopFunc[T any](s []T, lo int, x T, less func(T, T) bool) int {
+	if lo >= len(s) || !less(s[lo], x) {
+		return lo
+	}
+	prev, step := lo, 1
+	hi := lo + 1
+	for hi < len(s) && less(s[hi], x) {
+		prev = hi
+		step *= 2
+		hi = prev + step
+	}
+	if hi > len(s) {
+		hi = len(s)
+	}
+	return prev + 1 + lowerBoundFunc(s[prev+1:hi], x, less)
+}
+
+func appendDistinctFunc[T any](s []T, run []T, less func(T, T) bool) []T {
+	for _, x := range run {
+		if len(s) == 0 || less(s[len(s)-1], x) {
+			s = append(s, x)
+		}
+	}
+	return s
+}
+
+func initCursorsFunc[T any](h []cursor[T], less func(T, T) bool) {
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		downCursorsFunc(h, i, less)
+	}
+}
+
+func downCursorsFunc[T any](h []cursor[T], i int, less func(T, T) bool) {
+	for {
+		child := 2*i + 1
+		if child >= len(h) {
+			return
+		}
+		if child+1 < len(h) && less(h[child+1].head, h[child].head) {
+			child++
+		}
+		if !less(h[child].head, h[i].head) {
+			return
+		}
+		h[i], h[child] = h[child], h[i]
+		i = child
+	}
+}
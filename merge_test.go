@@ -0,0 +1,113 @@
+package slices_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func sortedInts(n, max int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = rand.Intn(max)
+	}
+	return slices.Sort(s)
+}
+
+func randomSortedSlices() [][]int {
+	s := make([][]int, rand.Intn(6))
+	for i := range s {
+		s[i] = sortedInts(rand.Intn(50), 1+rand.Intn(100))
+	}
+	return s
+}
+
+func TestUnionSorted(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		got := slices.UnionSorted([]int{1, 3, 3, 5}, []int{2, 3, 6}, []int{}, []int{0, 5})
+		assertEqual(t, []int{0, 1, 2, 3, 5, 6}, got)
+	})
+	t.Run("skewed", func(t *testing.T) {
+		a := sortedInts(10000, 1000000)
+		b := []int{-1, 500000, 2000000}
+		want := slices.Sort(slices.Union(a, b))
+		assertEqual(t, want, slices.UnionSorted(a, b))
+	})
+	t.Run("NaN", func(t *testing.T) {
+		nan := math.NaN()
+		got := slices.UnionSorted(slices.Sort([]float64{nan, 1, 2}), slices.Sort([]float64{nan, 2, 3}))
+		assertEqual(t, true, math.IsNaN(got[0]))
+		assertEqual(t, []float64{1, 2, 3}, got[1:])
+	})
+	t.Run("negative zero", func(t *testing.T) {
+		got := slices.UnionSorted([]float64{negZero, 1}, []float64{0, 1})
+		assertEqual(t, []float64{0, 0, 1}, got)
+		assertEqual(t, true, math.Signbit(got[0]))
+		assertEqual(t, false, math.Signbit(got[1]))
+	})
+	t.Run("random", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			s := randomSortedSlices()
+			assertEqual(t, slices.Sort(slices.Union(s...)), slices.UnionSorted(s...))
+		}
+	})
+}
+
+func TestIntersectionSorted(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		got := slices.IntersectionSorted([]int{1, 3, 3, 5, 7}, []int{2, 3, 5, 6, 7}, []int{3, 3, 7})
+		assertEqual(t, []int{3, 7}, got)
+	})
+	t.Run("skewed", func(t *testing.T) {
+		a := sortedInts(10000, 1000000)
+		b := []int{a[10], a[5000], 2000000}
+		want := slices.Sort(slices.Intersection(a, b))
+		assertEqual(t, want, slices.IntersectionSorted(a, b))
+	})
+	t.Run("NaN", func(t *testing.T) {
+		nan := math.NaN()
+		got := slices.IntersectionSorted(slices.Sort([]float64{nan, 1, 2}), slices.Sort([]float64{nan, 2, 3}))
+		assertEqual(t, 2, len(got))
+		assertEqual(t, true, math.IsNaN(got[0]))
+		assertEqual(t, 2.0, got[1])
+	})
+	t.Run("negative zero", func(t *testing.T) {
+		got := slices.IntersectionSorted([]float64{negZero, 1}, []float64{0, 1})
+		assertEqual(t, []float64{1}, got)
+	})
+	t.Run("random", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			s := randomSortedSlices()
+			assertEqual(t, slices.Sort(slices.Intersection(s...)), slices.IntersectionSorted(s...))
+		}
+	})
+}
+
+func TestDifferenceSorted(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		got := slices.DifferenceSorted([]int{1, 3, 3, 5, 7, 7}, []int{2, 3}, []int{5, 6})
+		assertEqual(t, []int{1, 7}, got)
+	})
+	t.Run("NaN", func(t *testing.T) {
+		nan := math.NaN()
+		got := slices.DifferenceSorted(slices.Sort([]float64{nan, 1, 2}), []float64{5})
+		assertEqual(t, 3, len(got))
+		assertEqual(t, true, math.IsNaN(got[0]))
+		assertEqual(t, []float64{1, 2}, got[1:])
+		got = slices.DifferenceSorted(slices.Sort([]float64{nan, 1, 2}), []float64{nan, 2})
+		assertEqual(t, []float64{1}, got)
+	})
+	t.Run("negative zero", func(t *testing.T) {
+		got := slices.DifferenceSorted([]float64{negZero, 0, 1}, []float64{0})
+		assertEqual(t, []float64{negZero, 1}, got)
+		assertEqual(t, true, math.Signbit(got[0]))
+	})
+	t.Run("random", func(t *testing.T) {
+		for i := 0; i < 200; i++ {
+			s := randomSortedSlices()
+			assertEqual(t, slices.Sort(slices.Difference(s...)), slices.DifferenceSorted(s...))
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package slices
+
+import (
+	"runtime"
+	"sync"
+)
+
+// defaultParallelCutoff is the default for ParallelOptions.Cutoff.
+const defaultParallelCutoff = 8192
+
+// ParallelOptions configures the parallel sorts. The zero value sorts
+// with one goroutine per CPU and the default cutoff.
+type ParallelOptions struct {
+	// Workers is the number of goroutines that sort at the same time.
+	// It defaults to runtime.GOMAXPROCS(0).
+	Workers int
+
+	// Cutoff is the least number of items that is worth handing to a
+	// goroutine of its own. Slices shorter than twice the cutoff are
+	// sorted serially. It defaults to 8192.
+	Cutoff int
+}
+
+// ParallelSort creates a new slice that is sorted in ascending order
+// by several goroutines and returns it. The sort is not guaranteed to
+// be stable. The given slice is not changed.
+func ParallelSort[T Ordered](s []T, opts ParallelOptions) []T {
+	c := Clone(s)
+	parallelSort(c, opts, sortOrdered[T], ascending[T]())
+	return c
+}
+
+// ParallelSortFunc creates a new slice that is sorted in ascending
+// order according to the given less func by several goroutines and
+// returns it. The sort is not guaranteed to be stable. The given slice
+// is not changed.
+func ParallelSortFunc[T any](s []T, less func(a, b T) bool, opts ParallelOptions) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	parallelSort(c, opts, func(run []T) {
+		introSortFunc(run, less)
+	}, less)
+	return c
+}
+
+// ParallelSortStable creates a new slice that is sorted in ascending
+// order by several goroutines and returns it. Items that are equal
+// keep their original order, so the result is the same as that of
+// SortStable. The given slice is not changed.
+func ParallelSortStable[T Ordered](s []T, opts ParallelOptions) []T {
+	c := Clone(s)
+	parallelSort(c, opts, sortStableOrdered[T], ascending[T]())
+	return c
+}
+
+// ParallelSortStableFunc creates a new slice that is sorted in
+// ascending order according to the given less func by several
+// goroutines and returns it. Items that are equal keep their original
+// order, so the result is the same as that of SortStableFunc. The
+// given slice is not changed.
+func ParallelSortStableFunc[T any](s []T, less func(a, b T) bool, opts ParallelOptions) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	parallelSort(c, opts, func(run []T) {
+		mergeSortFunc(run, less)
+	}, less)
+	return c
+}
+
+// parallelSort splits s into runs, sorts each run with sortRun in a
+// goroutine of its own and then merges neighbouring runs in parallel
+// until one is left. The merges are stable, so the result is stable if
+// sortRun is.
+func parallelSort[T any](s []T, opts ParallelOptions, sortRun func([]T), less func(a, b T) bool) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	cutoff := opts.Cutoff
+	if cutoff <= 0 {
+		cutoff = defaultParallelCutoff
+	}
+	runs := workers
+	if len(s)/runs < cutoff {
+		runs = len(s) / cutoff
+	}
+	if runs < 2 {
+		sortRun(s)
+		return
+	}
+	bounds := make([]int, runs+1)
+	for i := range bounds {
+		bounds[i] = i * len(s) / runs
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < runs; i++ {
+		wg.Add(1)
+		go func(run []T) {
+			defer wg.Done()
+			sortRun(run)
+		}(s[bounds[i]:bounds[i+1]])
+	}
+	wg.Wait()
+	src, dst := s, make([]T, len(s))
+	for len(bounds) > 2 {
+		pairs := (len(bounds) - 1) / 2
+		par := workers / pairs
+		next := make([]int, 0, pairs+2)
+		for i := 0; i < len(bounds)-1; i += 2 {
+			lo := bounds[i]
+			next = append(next, lo)
+			if i+2 >= len(bounds) {
+				copy(dst[lo:], src[lo:])
+				continue
+			}
+			mid, hi := bounds[i+1], bounds[i+2]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				parallelMerge(dst[lo:hi], src[lo:mid], src[mid:hi], less, cutoff, par)
+			}()
+		}
+		wg.Wait()
+		bounds = append(next, len(s))
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// parallelMerge stably merges the sorted runs a and b into dst, which
+// must have room for both, splitting the work between up to par
+// goroutines.
+func parallelMerge[T any](dst, a, b []T, less func(a, b T) bool, cutoff, par int) {
+	if par < 2 || len(dst) < 2*cutoff {
+		mergeInto(dst, a, b, less)
+		return
+	}
+	var i, j int
+	if len(a) >= len(b) {
+		i = len(a) / 2
+		j = lowerBoundFunc(b, a[i], less)
+	} else {
+		j = len(b) / 2
+		i = upperBoundFunc(a, b[j], less)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		parallelMerge(dst[:i+j], a[:i], b[:j], less, cutoff, par/2)
+	}()
+	parallelMerge(dst[i+j:], a[i:], b[j:], less, cutoff, par-par/2)
+	wg.Wait()
+}
+
+// mergeInto stably merges the sorted runs a and b into dst.
+func mergeInto[T any](dst, a, b []T, less func(a, b T) bool) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
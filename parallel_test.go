@@ -0,0 +1,63 @@
+package slices_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+var parallelOptions = []slices.ParallelOptions{
+	{},
+	{Workers: 1},
+	{Workers: 3, Cutoff: 10},
+	{Workers: 4, Cutoff: 100},
+	{Workers: 16, Cutoff: 1},
+}
+
+func TestParallelSort(t *testing.T) {
+	for name, s := range sortPatterns(5000) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			want := slices.Sort(s)
+			for _, opts := range parallelOptions {
+				assertEqual(t, want, slices.ParallelSort(s, opts))
+			}
+		})
+	}
+	t.Run("empty", func(t *testing.T) {
+		assertEqual(t, []int{}, slices.ParallelSort([]int{}, slices.ParallelOptions{Cutoff: 1}))
+	})
+}
+
+func TestParallelSortFunc(t *testing.T) {
+	s := sortPatterns(5000)["random"]
+	greater := func(a, b int) bool { return a > b }
+	want := slices.SortFunc(s, greater)
+	for _, opts := range parallelOptions {
+		assertEqual(t, want, slices.ParallelSortFunc(s, greater, opts))
+	}
+}
+
+func TestParallelSortStable(t *testing.T) {
+	s := make([]string, 5000)
+	for i := range s {
+		s[i] = string(rune('a' + rand.Intn(26)))
+	}
+	want := slices.SortStable(s)
+	for _, opts := range parallelOptions {
+		assertEqual(t, want, slices.ParallelSortStable(s, opts))
+	}
+}
+
+func TestParallelSortStableFunc(t *testing.T) {
+	s := make([]record, 5000)
+	for i := range s {
+		s[i] = record{id: rand.Intn(20), name: string(rune('a' + i%26))}
+	}
+	less := slices.By(recordID)
+	want := slices.SortStableFunc(s, less)
+	for _, opts := range parallelOptions {
+		assertEqual(t, want, slices.ParallelSortStableFunc(s, less, opts))
+	}
+}
@@ -0,0 +1,168 @@
+package slices
+
+// ArgSort returns the indices of the items of the given slice in the
+// order in which the items would be sorted in ascending order. Equal
+// items keep their original order, so Permute(s, ArgSort(s)) equals
+// SortStable(s). The given slice is not changed.
+func ArgSort[T Ordered](s []T) []int {
+	return ArgSortFunc(s, ascending[T]())
+}
+
+// ArgSortFunc returns the indices of the items of the given slice in
+// the order in which the items would be sorted in ascending order
+// according to the given less func. Equal items keep their original
+// order. The given slice is not changed.
+func ArgSortFunc[T any](s []T, less func(a, b T) bool) []int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	perm := identity(len(s))
+	mergeSortFunc(perm, func(i, j int) bool {
+		return less(s[i], s[j])
+	})
+	return perm
+}
+
+// ArgMax returns the index of the first max item in the given slice,
+// or -1 if it is empty. Items are compared like in Max.
+func ArgMax[T Ordered](s []T) int {
+	return ArgMaxFunc(s, ascending[T]())
+}
+
+// ArgMaxFunc returns the index of the first max item in the given
+// slice according to the given less func, or -1 if it is empty.
+func ArgMaxFunc[T any](s []T, less func(a, b T) bool) int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	if len(s) == 0 {
+		return -1
+	}
+	max := 0
+	for i := range s {
+		if less(s[max], s[i]) {
+			max = i
+		}
+	}
+	return max
+}
+
+// ArgMin returns the index of the first min item in the given slice,
+// or -1 if it is empty. Items are compared like in Min.
+func ArgMin[T Ordered](s []T) int {
+	return ArgMinFunc(s, ascending[T]())
+}
+
+// ArgMinFunc returns the index of the first min item in the given
+// slice according to the given less func, or -1 if it is empty.
+func ArgMinFunc[T any](s []T, less func(a, b T) bool) int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	if len(s) == 0 {
+		return -1
+	}
+	min := 0
+	for i := range s {
+		if less(s[i], s[min]) {
+			min = i
+		}
+	}
+	return min
+}
+
+// ArgTopK returns the indices of the k greatest items of the given
+// slice in descending order of the items. Of equal items, the one
+// with the lower index comes first. The given slice is not changed.
+func ArgTopK[T Ordered](s []T, k int) []int {
+	return ArgTopKFunc(s, k, ascending[T]())
+}
+
+// ArgTopKFunc returns the indices of the k greatest items of the
+// given slice according to the given less func in descending order of
+// the items. Of equal items, the one with the lower index comes first.
+// The given slice is not changed.
+func ArgTopKFunc[T any](s []T, k int, less func(a, b T) bool) []int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	return BottomKFunc(identity(len(s)), k, func(i, j int) bool {
+		if less(s[j], s[i]) {
+			return true
+		}
+		return !less(s[i], s[j]) && i < j
+	})
+}
+
+// Permute creates a new slice whose item at index i is the item of
+// the given slice at index perm[i] and returns it. It panics if perm
+// is not a permutation of the indices of the given slice. The given
+// slice is not changed.
+func Permute[T any](s []T, perm []int) []T {
+	checkPermutation(perm, len(s))
+	output := make([]T, len(s))
+	for i, p := range perm {
+		output[i] = s[p]
+	}
+	return output
+}
+
+// InversePermutation creates a new permutation that undoes the given
+// one and returns it, so Permute(Permute(s, perm), inv) equals s. It
+// panics if perm is not a permutation.
+func InversePermutation(perm []int) []int {
+	checkPermutation(perm, len(perm))
+	inv := make([]int, len(perm))
+	for i, p := range perm {
+		inv[p] = i
+	}
+	return inv
+}
+
+// ApplyPermutationInPlace reorders the given slice in place so that
+// its item at index i is the item that was at index perm[i], like
+// Permute does. Applying the same permutation to several slices of the
+// same length keeps them aligned. It panics if perm is not a
+// permutation of the indices of the given slice. The permutation is
+// not changed.
+func ApplyPermutationInPlace[T any](s []T, perm []int) {
+	checkPermutation(perm, len(s))
+	done := make([]bool, len(perm))
+	for start := range perm {
+		if done[start] {
+			continue
+		}
+		item := s[start]
+		i := start
+		for perm[i] != start {
+			s[i] = s[perm[i]]
+			done[i] = true
+			i = perm[i]
+		}
+		s[i] = item
+		done[i] = true
+	}
+}
+
+// identity returns the identity permutation of length n.
+func identity(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// checkPermutation panics unless perm is a permutation of 0 to n-1.
+func checkPermutation(perm []int, n int) {
+	if len(perm) != n {
+		panic("slices: invalid permutation")
+	}
+	seen := make([]bool, n)
+	for _, p := range perm {
+		if p < 0 || p >= n || seen[p] {
+			panic("slices: invalid permutation")
+		}
+		seen[p] = true
+	}
+}
@@ -0,0 +1,112 @@
+package slices_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func assertInvalidPermutation(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		assertEqual(t, "slices: invalid permutation", recover())
+	}()
+	f()
+}
+
+func TestArgSort(t *testing.T) {
+	s := []int{30, 10, 20, 10}
+	assertEqual(t, []int{1, 3, 2, 0}, slices.ArgSort(s))
+	assertEqual(t, []int{30, 10, 20, 10}, s)
+	assertEqual(t, []int{}, slices.ArgSort([]int{}))
+	for name, s := range sortPatterns(500) {
+		t.Run(name, func(t *testing.T) {
+			assertEqual(t, slices.SortStable(s), slices.Permute(s, slices.ArgSort(s)))
+		})
+	}
+	f := []float64{1, math.NaN(), 0}
+	assertEqual(t, []int{1, 2, 0}, slices.ArgSort(f))
+}
+
+func TestArgSortFunc(t *testing.T) {
+	less := slices.By(func(p person) int { return p.age })
+	got := slices.Permute(people, slices.ArgSortFunc(people, less))
+	assertEqual(t, slices.SortStableFunc(people, less), got)
+}
+
+func TestArgSortAligned(t *testing.T) {
+	names := []string{"carol", "alice", "bob"}
+	scores := []int{70, 90, 80}
+	ids := []int{3, 1, 2}
+	perm := slices.ArgSort(names)
+	slices.ApplyPermutationInPlace(names, perm)
+	slices.ApplyPermutationInPlace(scores, perm)
+	slices.ApplyPermutationInPlace(ids, perm)
+	assertEqual(t, []string{"alice", "bob", "carol"}, names)
+	assertEqual(t, []int{90, 80, 70}, scores)
+	assertEqual(t, []int{1, 2, 3}, ids)
+}
+
+func TestArgMax(t *testing.T) {
+	assertEqual(t, 1, slices.ArgMax([]int{1, 5, 3, 5}))
+	assertEqual(t, -1, slices.ArgMax([]int{}))
+	assertEqual(t, 2, slices.ArgMax([]float64{math.NaN(), 1, 2}))
+}
+
+func TestArgMaxFunc(t *testing.T) {
+	assertEqual(t, 3, slices.ArgMaxFunc(people, slices.By(func(p person) int { return p.age })))
+	assertEqual(t, -1, slices.ArgMaxFunc([]person{}, slices.By(func(p person) int { return p.age })))
+}
+
+func TestArgMin(t *testing.T) {
+	assertEqual(t, 0, slices.ArgMin([]int{1, 5, 1, 5}))
+	assertEqual(t, -1, slices.ArgMin([]string{}))
+	assertEqual(t, 1, slices.ArgMin([]float64{1, math.NaN(), 2}))
+}
+
+func TestArgMinFunc(t *testing.T) {
+	assertEqual(t, 1, slices.ArgMinFunc(people, slices.By(func(p person) int { return p.age })))
+}
+
+func TestArgTopK(t *testing.T) {
+	s := []int{5, 9, 1, 9, 7}
+	assertEqual(t, []int{1, 3, 4}, slices.ArgTopK(s, 3))
+	assertEqual(t, []int{1, 3, 4, 0, 2}, slices.ArgTopK(s, 10))
+	assertEqual(t, []int{}, slices.ArgTopK(s, 0))
+}
+
+func TestArgTopKFunc(t *testing.T) {
+	got := slices.ArgTopKFunc(people, 2, slices.By(func(p person) int { return p.age }))
+	assertEqual(t, []int{3, 0}, got)
+}
+
+func TestPermute(t *testing.T) {
+	s := []string{"a", "b", "c"}
+	assertEqual(t, []string{"c", "a", "b"}, slices.Permute(s, []int{2, 0, 1}))
+	assertEqual(t, []string{"a", "b", "c"}, s)
+	assertInvalidPermutation(t, func() { slices.Permute(s, []int{0, 1}) })
+	assertInvalidPermutation(t, func() { slices.Permute(s, []int{0, 1, 1}) })
+	assertInvalidPermutation(t, func() { slices.Permute(s, []int{0, 1, 3}) })
+}
+
+func TestInversePermutation(t *testing.T) {
+	perm := rand.Perm(100)
+	s := rand.Perm(100)
+	inv := slices.InversePermutation(perm)
+	assertEqual(t, s, slices.Permute(slices.Permute(s, perm), inv))
+	assertEqual(t, s, slices.Permute(slices.Permute(s, inv), perm))
+	assertInvalidPermutation(t, func() { slices.InversePermutation([]int{-1, 0}) })
+}
+
+func TestApplyPermutationInPlace(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 100} {
+		perm := rand.Perm(n)
+		s := rand.Perm(n)
+		want := slices.Permute(s, perm)
+		slices.ApplyPermutationInPlace(s, perm)
+		assertEqual(t, want, s)
+	}
+	assertInvalidPermutation(t, func() { slices.ApplyPermutationInPlace([]int{1, 2}, []int{1, 1}) })
+}
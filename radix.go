@@ -0,0 +1,139 @@
+package slices
+
+import (
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// radixThreshold is the length from which Sort uses radix sort for
+// integer and floating point types.
+const radixThreshold = 256
+
+type signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// radixSort sorts s in place with an LSD radix sort and reports
+// whether it could. Only integer and floating point types can be
+// radix sorted. Floating point NaNs are sorted before all other
+// values and -0 is sorted before +0.
+func radixSort[T Ordered](s []T) bool {
+	var zero T
+	p := unsafe.Pointer(&s)
+	switch reflect.TypeOf(zero).Kind() {
+	case reflect.Int:
+		radixSortSigned(*(*[]int)(p))
+	case reflect.Int8:
+		radixSortSigned(*(*[]int8)(p))
+	case reflect.Int16:
+		radixSortSigned(*(*[]int16)(p))
+	case reflect.Int32:
+		radixSortSigned(*(*[]int32)(p))
+	case reflect.Int64:
+		radixSortSigned(*(*[]int64)(p))
+	case reflect.Uint:
+		radixSortUnsigned(*(*[]uint)(p))
+	case reflect.Uint8:
+		radixSortUnsigned(*(*[]uint8)(p))
+	case reflect.Uint16:
+		radixSortUnsigned(*(*[]uint16)(p))
+	case reflect.Uint32:
+		radixSortUnsigned(*(*[]uint32)(p))
+	case reflect.Uint64:
+		radixSortUnsigned(*(*[]uint64)(p))
+	case reflect.Uintptr:
+		radixSortUnsigned(*(*[]uintptr)(p))
+	case reflect.Float32:
+		radixSortFloat(*(*[]float32)(p))
+	case reflect.Float64:
+		radixSortFloat(*(*[]float64)(p))
+	default:
+		return false
+	}
+	return true
+}
+
+func radixSortSigned[I signed](s []I) {
+	width := int(unsafe.Sizeof(s[0]))
+	mask := uint64(math.MaxUint64) >> (64 - 8*width)
+	sign := uint64(1) << (8*width - 1)
+	radixSortBy(s, width, func(v I) uint64 {
+		return uint64(v)&mask ^ sign
+	})
+}
+
+func radixSortUnsigned[U unsigned](s []U) {
+	radixSortBy(s, int(unsafe.Sizeof(s[0])), func(v U) uint64 {
+		return uint64(v)
+	})
+}
+
+func radixSortFloat[F Float](s []F) {
+	s = s[moveNaNsFront(s):]
+	radixSortBy(s, 8, func(v F) uint64 {
+		b := math.Float64bits(float64(v))
+		if b&(1<<63) != 0 {
+			return ^b
+		}
+		return b | 1<<63
+	})
+}
+
+// moveNaNsFront moves the NaNs in s to the front and returns how many
+// there are.
+func moveNaNsFront[F Float](s []F) int {
+	n := 0
+	for i := range s {
+		if s[i] != s[i] {
+			s[n], s[i] = s[i], s[n]
+			n++
+		}
+	}
+	return n
+}
+
+// radixSortBy sorts s in place by the keys returned by the given key
+// func, whose only non zero bytes are the width least significant
+// ones. Passes over bytes that are the same in every key are skipped.
+func radixSortBy[E any](s []E, width int, key func(E) uint64) {
+	if len(s) < 2 {
+		return
+	}
+	var counts [8][256]int
+	for _, v := range s {
+		k := key(v)
+		for b := 0; b < width; b++ {
+			counts[b][byte(k>>(8*b))]++
+		}
+	}
+	src, dst := s, []E(nil)
+	first := key(s[0])
+	for b := 0; b < width; b++ {
+		c := &counts[b]
+		shift := 8 * b
+		if c[byte(first>>shift)] == len(s) {
+			continue
+		}
+		sum := 0
+		for i := range c {
+			c[i], sum = sum, sum+c[i]
+		}
+		if dst == nil {
+			dst = make([]E, len(s))
+		}
+		for _, v := range src {
+			d := byte(key(v) >> shift)
+			dst[c[d]] = v
+			c[d]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
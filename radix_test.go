@@ -0,0 +1,88 @@
+package slices_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func testRadixSort[T slices.Ordered](t *testing.T, gen func() T) {
+	s := make([]T, 2000)
+	for i := range s {
+		s[i] = gen()
+	}
+	got := slices.Sort(s)
+	want := slices.Clone(s)
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	assertEqual(t, want, got)
+}
+
+func TestSortRadix(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		testRadixSort(t, func() int { return rand.Int() - rand.Int() })
+	})
+	t.Run("int8", func(t *testing.T) {
+		testRadixSort(t, func() int8 { return int8(rand.Intn(256) - 128) })
+	})
+	t.Run("int16", func(t *testing.T) {
+		testRadixSort(t, func() int16 { return int16(rand.Intn(65536) - 32768) })
+	})
+	t.Run("int32", func(t *testing.T) {
+		testRadixSort(t, func() int32 { return rand.Int31() - rand.Int31() })
+	})
+	t.Run("int64", func(t *testing.T) {
+		testRadixSort(t, func() int64 { return rand.Int63() - rand.Int63() })
+	})
+	t.Run("int64 extremes", func(t *testing.T) {
+		values := []int64{math.MinInt64, math.MaxInt64, 0, -1, 1}
+		testRadixSort(t, func() int64 { return values[rand.Intn(len(values))] })
+	})
+	t.Run("uint", func(t *testing.T) {
+		testRadixSort(t, func() uint { return uint(rand.Uint64()) })
+	})
+	t.Run("uint8", func(t *testing.T) {
+		testRadixSort(t, func() uint8 { return uint8(rand.Intn(256)) })
+	})
+	t.Run("uint16", func(t *testing.T) {
+		testRadixSort(t, func() uint16 { return uint16(rand.Intn(65536)) })
+	})
+	t.Run("uint32", func(t *testing.T) {
+		testRadixSort(t, rand.Uint32)
+	})
+	t.Run("uint64", func(t *testing.T) {
+		testRadixSort(t, rand.Uint64)
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		testRadixSort(t, func() uintptr { return uintptr(rand.Uint32()) })
+	})
+	t.Run("float32", func(t *testing.T) {
+		testRadixSort(t, func() float32 { return float32(rand.NormFloat64()) })
+	})
+	t.Run("float64", func(t *testing.T) {
+		testRadixSort(t, func() float64 { return rand.NormFloat64() * 1e10 })
+	})
+	t.Run("named", func(t *testing.T) {
+		testRadixSort(t, func() userID { return userID(rand.Int63() - rand.Int63()) })
+	})
+}
+
+func TestSortRadixSpecialFloats(t *testing.T) {
+	s := make([]float64, 1000)
+	for i := range s {
+		s[i] = rand.NormFloat64()
+	}
+	negZero := math.Copysign(0, -1)
+	s[3], s[10], s[500] = math.NaN(), math.Inf(1), math.Inf(-1)
+	s[20], s[30], s[40] = 0, negZero, math.NaN()
+	got := slices.Sort(s)
+	assertEqual(t, true, math.IsNaN(got[0]) && math.IsNaN(got[1]))
+	assertEqual(t, math.Inf(-1), got[2])
+	assertEqual(t, math.Inf(1), got[len(got)-1])
+	zero := slices.IndexOfFunc(got, func(f float64) bool { return f == 0 })
+	assertEqual(t, true, math.Signbit(got[zero]))
+	assertEqual(t, false, math.Signbit(got[zero+1]))
+	assertEqual(t, true, sort.Float64sAreSorted(got))
+}
@@ -0,0 +1,116 @@
+package slices
+
+// Rank returns the rank of each item of the given slice in ascending
+// order, like SQL's RANK: the rank of an item is one plus the number
+// of items less than it, so equal items share a rank and the ranks
+// after them are skipped. The ranks are in the order of the items.
+func Rank[T Ordered](s []T) []int {
+	output := make([]int, len(s))
+	rank(s, ascending[T](), func(i, pos, lo, hi, group int) {
+		output[i] = lo + 1
+	})
+	return output
+}
+
+// RankBy returns the rank of each item of the given slice like Rank,
+// ordering the items by the keys returned by the given key func.
+func RankBy[T any, K Ordered](s []T, key func(item T) K) []int {
+	return Rank(Map(s, key))
+}
+
+// DenseRank returns the rank of each item of the given slice in
+// ascending order, like SQL's DENSE_RANK: equal items share a rank and
+// no ranks are skipped. The ranks are in the order of the items.
+func DenseRank[T Ordered](s []T) []int {
+	output := make([]int, len(s))
+	rank(s, ascending[T](), func(i, pos, lo, hi, group int) {
+		output[i] = group + 1
+	})
+	return output
+}
+
+// DenseRankBy returns the rank of each item of the given slice like
+// DenseRank, ordering the items by the keys returned by the given key
+// func.
+func DenseRankBy[T any, K Ordered](s []T, key func(item T) K) []int {
+	return DenseRank(Map(s, key))
+}
+
+// RowNumber returns the position of each item of the given slice in
+// ascending order, counting from one, like SQL's ROW_NUMBER. Equal
+// items are numbered in their original order. The numbers are in the
+// order of the items.
+func RowNumber[T Ordered](s []T) []int {
+	output := make([]int, len(s))
+	rank(s, ascending[T](), func(i, pos, lo, hi, group int) {
+		output[i] = pos + 1
+	})
+	return output
+}
+
+// RowNumberBy returns the position of each item of the given slice
+// like RowNumber, ordering the items by the keys returned by the given
+// key func.
+func RowNumberBy[T any, K Ordered](s []T, key func(item T) K) []int {
+	return RowNumber(Map(s, key))
+}
+
+// PercentRank returns the relative rank of each item of the given
+// slice in ascending order, like SQL's PERCENT_RANK: (rank - 1) / (n -
+// 1), where rank is the item's Rank and n the length of the slice. It
+// is zero for a slice with one item. The relative ranks are in the
+// order of the items.
+func PercentRank[T Ordered](s []T) []float64 {
+	output := make([]float64, len(s))
+	rank(s, ascending[T](), func(i, pos, lo, hi, group int) {
+		if len(s) > 1 {
+			output[i] = float64(lo) / float64(len(s)-1)
+		}
+	})
+	return output
+}
+
+// PercentRankBy returns the relative rank of each item of the given
+// slice like PercentRank, ordering the items by the keys returned by
+// the given key func.
+func PercentRankBy[T any, K Ordered](s []T, key func(item T) K) []float64 {
+	return PercentRank(Map(s, key))
+}
+
+// CumeDist returns the cumulative distribution of each item of the
+// given slice in ascending order, like SQL's CUME_DIST: the fraction
+// of items that are less than or equal to it. The fractions are in
+// the order of the items.
+func CumeDist[T Ordered](s []T) []float64 {
+	output := make([]float64, len(s))
+	rank(s, ascending[T](), func(i, pos, lo, hi, group int) {
+		output[i] = float64(hi) / float64(len(s))
+	})
+	return output
+}
+
+// CumeDistBy returns the cumulative distribution of each item of the
+// given slice like CumeDist, ordering the items by the keys returned
+// by the given key func.
+func CumeDistBy[T any, K Ordered](s []T, key func(item T) K) []float64 {
+	return CumeDist(Map(s, key))
+}
+
+// rank stably sorts the indices of s according to less and calls f
+// for the item at each index i with its position pos in sorted order,
+// the positions lo and hi that bound the group of items equal to it
+// and the number of groups before that one.
+func rank[T any](s []T, less func(a, b T) bool, f func(i, pos, lo, hi, group int)) {
+	perm := ArgSortFunc(s, less)
+	group := 0
+	for lo := 0; lo < len(perm); group++ {
+		hi := lo + 1
+		for hi < len(perm) && !less(s[perm[lo]], s[perm[hi]]) {
+			hi++
+		}
+		for pos := lo; pos < hi; pos++ {
+			f(perm[pos], pos, lo, hi, group)
+		}
+		lo = hi
+	}
+}
@@ -0,0 +1,62 @@
+package slices_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+var scores = []int{80, 95, 80, 70, 95, 60}
+
+func TestRank(t *testing.T) {
+	assertEqual(t, []int{3, 5, 3, 2, 5, 1}, slices.Rank(scores))
+	assertEqual(t, []int{}, slices.Rank([]int{}))
+	assertEqual(t, []int{2, 1, 2}, slices.Rank([]float64{1, math.NaN(), 1}))
+}
+
+func TestRankBy(t *testing.T) {
+	got := slices.RankBy(people, func(p person) int { return -p.age })
+	assertEqual(t, []int{2, 4, 2, 1, 4}, got)
+}
+
+func TestDenseRank(t *testing.T) {
+	assertEqual(t, []int{3, 4, 3, 2, 4, 1}, slices.DenseRank(scores))
+	assertEqual(t, []int{1, 1}, slices.DenseRank([]string{"a", "a"}))
+}
+
+func TestDenseRankBy(t *testing.T) {
+	got := slices.DenseRankBy(people, func(p person) int { return p.age })
+	assertEqual(t, []int{2, 1, 2, 3, 1}, got)
+}
+
+func TestRowNumber(t *testing.T) {
+	assertEqual(t, []int{3, 5, 4, 2, 6, 1}, slices.RowNumber(scores))
+}
+
+func TestRowNumberBy(t *testing.T) {
+	got := slices.RowNumberBy(people, func(p person) int { return p.age })
+	assertEqual(t, []int{3, 1, 4, 5, 2}, got)
+}
+
+func TestPercentRank(t *testing.T) {
+	assertEqual(t, []float64{0.4, 0.8, 0.4, 0.2, 0.8, 0}, slices.PercentRank(scores))
+	assertEqual(t, []float64{0}, slices.PercentRank([]int{7}))
+	assertEqual(t, []float64{}, slices.PercentRank([]int{}))
+}
+
+func TestPercentRankBy(t *testing.T) {
+	got := slices.PercentRankBy(people, func(p person) int { return p.age })
+	assertEqual(t, []float64{0.5, 0, 0.5, 1, 0}, got)
+}
+
+func TestCumeDist(t *testing.T) {
+	got := slices.CumeDist([]int{1, 2, 2, 4})
+	assertEqual(t, []float64{0.25, 0.75, 0.75, 1}, got)
+	assertEqual(t, []float64{}, slices.CumeDist([]int{}))
+}
+
+func TestCumeDistBy(t *testing.T) {
+	got := slices.CumeDistBy(people, func(p person) int { return p.age })
+	assertEqual(t, []float64{0.8, 0.4, 0.8, 1, 0.4}, got)
+}
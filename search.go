@@ -0,0 +1,118 @@
+package slices
+
+// BinarySearchCmp searches for the target in the given slice, which
+// must be sorted in ascending order according to the given compare
+// func. It returns the index at which the target is found or would be
+// inserted, and whether it was found.
+func BinarySearchCmp[T any, X any](s []T, target X, cmp func(item T, target X) int) (int, bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if cmp(s[m], target) < 0 {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo, lo < len(s) && cmp(s[lo], target) == 0
+}
+
+// BinarySearch searches for the item in the given slice, which must be
+// sorted in ascending order. It returns the index of the first item
+// equal to the given item, or the index at which it can be inserted
+// with Splice to keep the slice sorted, and whether it was found.
+// Floating point values are compared in the total order of Compare.
+func BinarySearch[T Ordered](s []T, item T) (int, bool) {
+	i := LowerBound(s, item)
+	return i, i < len(s) && Compare(item, s[i]) == 0
+}
+
+// BinarySearchFunc searches for the item in the given slice, which
+// must be sorted in ascending order according to the given less func.
+// It returns the index of the first item equal to the given item, or
+// the index at which it can be inserted with Splice to keep the slice
+// sorted, and whether it was found.
+func BinarySearchFunc[T any](s []T, item T, less func(a, b T) bool) (int, bool) {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	i := lowerBoundFunc(s, item, less)
+	return i, i < len(s) && !less(item, s[i])
+}
+
+// LowerBound returns the index of the first item in the given slice,
+// which must be sorted in ascending order, that is not less than the
+// given item. This is the first index at which the item can be
+// inserted with Splice to keep the slice sorted.
+func LowerBound[T Ordered](s []T, item T) int {
+	if isFloat[T]() {
+		return lowerBoundFunc(s, item, ascending[T]())
+	}
+	return lowerBound(s, item)
+}
+
+// LowerBoundFunc returns the index of the first item in the given
+// slice, which must be sorted in ascending order according to the
+// given less func, that is not less than the given item.
+func LowerBoundFunc[T any](s []T, item T, less func(a, b T) bool) int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	return lowerBoundFunc(s, item, less)
+}
+
+// UpperBound returns the index of the first item in the given slice,
+// which must be sorted in ascending order, that is greater than the
+// given item. This is the last index at which the item can be inserted
+// with Splice to keep the slice sorted.
+func UpperBound[T Ordered](s []T, item T) int {
+	if isFloat[T]() {
+		return upperBoundFunc(s, item, ascending[T]())
+	}
+	return upperBound(s, item)
+}
+
+// UpperBoundFunc returns the index of the first item in the given
+// slice, which must be sorted in ascending order according to the
+// given less func, that is greater than the given item.
+func UpperBoundFunc[T any](s []T, item T, less func(a, b T) bool) int {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	return upperBoundFunc(s, item, less)
+}
+
+// EqualRange returns the bounds of the range of items in the given
+// slice, which must be sorted in ascending order, that are equal to
+// the given item. The range is empty if there are no such items.
+func EqualRange[T Ordered](s []T, item T) (int, int) {
+	lo := LowerBound(s, item)
+	return lo, lo + UpperBound(s[lo:], item)
+}
+
+// EqualRangeFunc returns the bounds of the range of items in the given
+// slice, which must be sorted in ascending order according to the
+// given less func, that are equal to the given item. The range is
+// empty if there are no such items.
+func EqualRangeFunc[T any](s []T, item T, less func(a, b T) bool) (int, int) {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	lo := lowerBoundFunc(s, item, less)
+	return lo, lo + upperBoundFunc(s[lo:], item, less)
+}
+
+// ContainsSorted checks if any of the items in the given slice, which
+// must be sorted in ascending order, are equal to the given item.
+func ContainsSorted[T Ordered](s []T, item T) bool {
+	_, ok := BinarySearch(s, item)
+	return ok
+}
+
+// ContainsSortedFunc checks if any of the items in the given slice,
+// which must be sorted in ascending order according to the given less
+// func, are equal to the given item.
+func ContainsSortedFunc[T any](s []T, item T, less func(a, b T) bool) bool {
+	_, ok := BinarySearchFunc(s, item, less)
+	return ok
+}
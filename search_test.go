@@ -0,0 +1,95 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func TestBinarySearchCmp(t *testing.T) {
+	s := []person{{"Alice", 25}, {"bob", 30}, {"carol", 30}, {"alice", 40}}
+	cmp := func(p person, age int) int { return slices.Compare(p.age, age) }
+	t.Run("found", func(t *testing.T) {
+		i, ok := slices.BinarySearchCmp(s, 30, cmp)
+		assertEqual(t, 1, i)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		i, ok := slices.BinarySearchCmp(s, 35, cmp)
+		assertEqual(t, 3, i)
+		assertEqual(t, false, ok)
+	})
+	t.Run("past end", func(t *testing.T) {
+		i, ok := slices.BinarySearchCmp(s, 50, cmp)
+		assertEqual(t, 4, i)
+		assertEqual(t, false, ok)
+	})
+}
+
+var sortedSearchSlice = []int{1, 3, 3, 3, 5, 8}
+
+func lessInt(a, b int) bool {
+	return a < b
+}
+
+func TestBinarySearch(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		i, ok := slices.BinarySearch(sortedSearchSlice, 3)
+		assertEqual(t, 1, i)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		i, ok := slices.BinarySearch(sortedSearchSlice, 4)
+		assertEqual(t, 4, i)
+		assertEqual(t, false, ok)
+		assertEqual(t, []int{1, 3, 3, 3, 4, 5, 8}, slices.Splice(sortedSearchSlice, i, 0, 4))
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		i, ok := slices.BinarySearch([]int{}, 4)
+		assertEqual(t, 0, i)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		i, ok := slices.BinarySearchFunc(sortedSearchSlice, 8, lessInt)
+		assertEqual(t, 5, i)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		i, ok := slices.BinarySearchFunc(sortedSearchSlice, 9, lessInt)
+		assertEqual(t, 6, i)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestLowerBound(t *testing.T) {
+	assertEqual(t, 1, slices.LowerBound(sortedSearchSlice, 3))
+	assertEqual(t, 0, slices.LowerBound(sortedSearchSlice, 0))
+	assertEqual(t, 1, slices.LowerBoundFunc(sortedSearchSlice, 2, lessInt))
+}
+
+func TestUpperBound(t *testing.T) {
+	assertEqual(t, 4, slices.UpperBound(sortedSearchSlice, 3))
+	assertEqual(t, 6, slices.UpperBound(sortedSearchSlice, 8))
+	assertEqual(t, 4, slices.UpperBoundFunc(sortedSearchSlice, 4, lessInt))
+}
+
+func TestEqualRange(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		lo, hi := slices.EqualRange(sortedSearchSlice, 3)
+		assertEqual(t, []int{1, 4}, []int{lo, hi})
+	})
+	t.Run("not found", func(t *testing.T) {
+		lo, hi := slices.EqualRangeFunc(sortedSearchSlice, 6, lessInt)
+		assertEqual(t, []int{5, 5}, []int{lo, hi})
+	})
+}
+
+func TestContainsSorted(t *testing.T) {
+	assertEqual(t, true, slices.ContainsSorted(sortedSearchSlice, 5))
+	assertEqual(t, false, slices.ContainsSorted(sortedSearchSlice, 6))
+	assertEqual(t, true, slices.ContainsSortedFunc(sortedSearchSlice, 1, lessInt))
+	assertEqual(t, false, slices.ContainsSortedFunc(sortedSearchSlice, 0, lessInt))
+}
@@ -0,0 +1,351 @@
+package slices
+
+import "math"
+
+// Number is a constraint that permits any integer or floating point
+// type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// QuantileMethod determines how Quantile picks a value when the
+// requested quantile falls between two items.
+type QuantileMethod int
+
+const (
+	// Linear interpolates linearly between the two items.
+	Linear QuantileMethod = iota
+
+	// Nearest picks the nearer of the two items, or the one with the
+	// even index when both are equally near.
+	Nearest
+
+	// Lower picks the lesser of the two items.
+	Lower
+
+	// Higher picks the greater of the two items.
+	Higher
+)
+
+// NthElement creates a new slice with the items of the given slice in
+// which the item at index n is the item that would be there if the
+// slice were sorted and returns it. No item before index n is greater
+// than it and no item after index n is less than it. The given slice
+// is not changed.
+func NthElement[T Ordered](s []T, n int) []T {
+	if isFloat[T]() {
+		return NthElementFunc(s, n, ascending[T]())
+	}
+	c := Clone(s)
+	nthElement(c, n)
+	return c
+}
+
+// NthElementFunc creates a new slice with the items of the given slice
+// in which the item at index n is the item that would be there if the
+// slice were sorted according to the given less func and returns it.
+// No item before index n is greater than it and no item after index n
+// is less than it. The given slice is not changed.
+func NthElementFunc[T any](s []T, n int, less func(a, b T) bool) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	nthElementFunc(c, n, less)
+	return c
+}
+
+// Select returns the item that would be at index n if the given slice
+// were sorted. It runs in linear time on average and O(n log n) time
+// in the worst case.
+func Select[T Ordered](s []T, n int) T {
+	return NthElement(s, n)[n]
+}
+
+// SelectFunc returns the item that would be at index n if the given
+// slice were sorted according to the given less func.
+func SelectFunc[T any](s []T, n int, less func(a, b T) bool) T {
+	return NthElementFunc(s, n, less)[n]
+}
+
+// Median returns the median of the items in the given slice. It
+// returns the mean of the two middle items if the slice has an even
+// number of items, zero if it is empty and NaN if any of its items are
+// NaN.
+func Median[T Number](s []T) float64 {
+	return QuantileWith(s, 0.5, Linear)
+}
+
+// Quantile returns the q-quantile of the items in the given slice,
+// interpolating linearly between items. It returns zero if the slice
+// is empty, NaN if any of its items are NaN, and panics if q is not
+// between 0 and 1.
+func Quantile[T Number](s []T, q float64) float64 {
+	return QuantileWith(s, q, Linear)
+}
+
+// QuantileWith returns the q-quantile of the items in the given slice,
+// using the given method when it falls between two items. The methods
+// match those of NumPy's quantile function. It returns zero if the
+// slice is empty, NaN if any of its items are NaN, and panics if q is
+// not between 0 and 1.
+func QuantileWith[T Number](s []T, q float64, method QuantileMethod) float64 {
+	if !(q >= 0 && q <= 1) {
+		panic("slices: quantile out of range")
+	}
+	if len(s) == 0 {
+		return 0
+	}
+	f := make([]float64, len(s))
+	for i := range s {
+		f[i] = float64(s[i])
+		if f[i] != f[i] {
+			return f[i]
+		}
+	}
+	h := q * float64(len(f)-1)
+	lo := int(math.Floor(h))
+	hi := int(math.Ceil(h))
+	switch method {
+	case Nearest:
+		i := int(math.RoundToEven(h))
+		nthElement(f, i)
+		return f[i]
+	case Higher:
+		nthElement(f, hi)
+		return f[hi]
+	}
+	nthElement(f, lo)
+	if method == Lower || hi == lo {
+		return f[lo]
+	}
+	next := f[lo+1]
+	for _, x := range f[lo+2:] {
+		if x < next {
+			next = x
+		}
+	}
+	return f[lo] + (h-float64(lo))*(next-f[lo])
+}
+
+// TopK creates a new slice that contains the k greatest items of the
+// given slice in descending order and returns it. The given slice is
+// not changed.
+func TopK[T Ordered](s []T, k int) []T {
+	return BottomKFunc(s, k, descending[T]())
+}
+
+// TopKFunc creates a new slice that contains the k greatest items of
+// the given slice according to the given less func in descending
+// order and returns it. The given slice is not changed.
+func TopKFunc[T any](s []T, k int, less func(a, b T) bool) []T {
+	return BottomKFunc(s, k, func(a, b T) bool {
+		return less(b, a)
+	})
+}
+
+// BottomK creates a new slice that contains the k least items of the
+// given slice in ascending order and returns it. The given slice is
+// not changed.
+func BottomK[T Ordered](s []T, k int) []T {
+	if isFloat[T]() {
+		return BottomKFunc(s, k, ascending[T]())
+	}
+	k = clampK(k, len(s))
+	h := Clone(s[:k])
+	heapSelect(h, s[k:])
+	heapSort(h, 0, k-1)
+	return h
+}
+
+// BottomKFunc creates a new slice that contains the k least items of
+// the given slice according to the given less func in ascending order
+// and returns it. The given slice is not changed.
+func BottomKFunc[T any](s []T, k int, less func(a, b T) bool) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	k = clampK(k, len(s))
+	h := Clone(s[:k])
+	heapSelectFunc(h, s[k:], less)
+	heapSortFunc(h, 0, k-1, less)
+	return h
+}
+
+// PartialSort creates a new slice with the items of the given slice
+// in which the first k items are the k least items in ascending order
+// and returns it. The order of the remaining items is unspecified. The
+// given slice is not changed.
+func PartialSort[T Ordered](s []T, k int) []T {
+	if isFloat[T]() {
+		return PartialSortFunc(s, k, ascending[T]())
+	}
+	c := Clone(s)
+	k = clampK(k, len(c))
+	if k > 0 {
+		heapify(c[:k])
+		for i := k; i < len(c); i++ {
+			if c[i] < c[0] {
+				c[0], c[i] = c[i], c[0]
+				siftDown(c, 0, 0, k)
+			}
+		}
+	}
+	heapSort(c, 0, k-1)
+	return c
+}
+
+// PartialSortFunc creates a new slice with the items of the given
+// slice in which the first k items are the k least items according to
+// the given less func in ascending order and returns it. The order of
+// the remaining items is unspecified. The given slice is not changed.
+func PartialSortFunc[T any](s []T, k int, less func(a, b T) bool) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	k = clampK(k, len(c))
+	if k > 0 {
+		heapifyFunc(c[:k], less)
+		for i := k; i < len(c); i++ {
+			if less(c[i], c[0]) {
+				c[0], c[i] = c[i], c[0]
+				siftDownFunc(c, 0, 0, k, less)
+			}
+		}
+	}
+	heapSortFunc(c, 0, k-1, less)
+	return c
+}
+
+func clampK(k, n int) int {
+	if k < 0 {
+		return 0
+	}
+	if k > n {
+		return n
+	}
+	return k
+}
+
+func heapify[T Ordered](h []T) {
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		siftDown(h, 0, i, len(h))
+	}
+}
+
+// heapSelect turns h into a max heap and replaces its root with every
+// item of rest that is less than it, so h ends up holding the len(h)
+// least items of h and rest.
+func heapSelect[T Ordered](h []T, rest []T) {
+	if len(h) == 0 {
+		return
+	}
+	heapify(h)
+	for _, x := range rest {
+		if x < h[0] {
+			h[0] = x
+			siftDown(h, 0, 0, len(h))
+		}
+	}
+}
+
+func heapifyFunc[T any](h []T, less func(T, T) bool) {
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		siftDownFunc(h, 0, i, len(h), less)
+	}
+}
+
+func heapSelectFunc[T any](h []T, rest []T, less func(T, T) bool) {
+	if len(h) == 0 {
+		return
+	}
+	heapifyFunc(h, less)
+	for _, x := range rest {
+		if less(x, h[0]) {
+			h[0] = x
+			siftDownFunc(h, 0, 0, len(h), less)
+		}
+	}
+}
+
+// nthElement rearranges s so that s[n] is the item that would be there
+// if s were sorted, with no greater items before it and no lesser
+// items after it. Partitions are quick selected with a depth limit,
+// after which pivots are chosen by the median of medians.
+func nthElement[T Ordered](s []T, n int) {
+	low, high := 0, len(s)-1
+	depth := maxDepth(len(s))
+	for high-low >= insertionSortThreshold {
+		var lt, gt int
+		if depth == 0 {
+			lt, gt = partitionAt(s, low, high, medianOfMedians(s, low, high))
+		} else {
+			depth--
+			lt, gt = partition(s, low, high)
+		}
+		switch {
+		case n < lt:
+			high = lt - 1
+		case n > gt:
+			low = gt + 1
+		default:
+			return
+		}
+	}
+	insertionSort(s, low, high)
+}
+
+// medianOfMedians moves the medians of the groups of five items of
+// s[low:high+1] to the front of it and returns the index of the median
+// of those medians.
+func medianOfMedians[T Ordered](s []T, low, high int) int {
+	g := 0
+	for i := low; i <= high; i += 5 {
+		end := minInt(i+4, high)
+		insertionSort(s, i, end)
+		m := i + (end-i)/2
+		s[low+g], s[m] = s[m], s[low+g]
+		g++
+	}
+	nthElement(s[low:low+g], g/2)
+	return low + g/2
+}
+
+func nthElementFunc[T any](s []T, n int, less func(T, T) bool) {
+	low, high := 0, len(s)-1
+	depth := maxDepth(len(s))
+	for high-low >= insertionSortThreshold {
+		var lt, gt int
+		if depth == 0 {
+			lt, gt = partitionAtFunc(s, low, high, medianOfMediansFunc(s, low, high, less), less)
+		} else {
+			depth--
+			lt, gt = partitionFunc(s, low, high, less)
+		}
+		switch {
+		case n < lt:
+			high = lt - 1
+		case n > gt:
+			low = gt + 1
+		default:
+			return
+		}
+	}
+	insertionSortFunc(s, low, high, less)
+}
+
+func medianOfMediansFunc[T any](s []T, low, high int, less func(T, T) bool) int {
+	g := 0
+	for i := low; i <= high; i += 5 {
+		end := minInt(i+4, high)
+		insertionSortFunc(s, i, end, less)
+		m := i + (end-i)/2
+		s[low+g], s[m] = s[m], s[low+g]
+		g++
+	}
+	nthElementFunc(s[low:low+g], g/2, less)
+	return low + g/2
+}
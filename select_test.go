@@ -0,0 +1,220 @@
+package slices_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func TestTopK(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7, 9, 2}
+	t.Run("common", func(t *testing.T) {
+		assertEqual(t, []int{9, 9, 7}, slices.TopK(s, 3))
+	})
+	t.Run("k too large", func(t *testing.T) {
+		assertEqual(t, []int{9, 9, 7, 5, 3, 2, 1}, slices.TopK(s, 10))
+	})
+	t.Run("k zero", func(t *testing.T) {
+		assertEqual(t, []int{}, slices.TopK(s, 0))
+		assertEqual(t, []int{}, slices.TopK(s, -1))
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		assertEqual(t, []int{5, 1, 9, 3, 7, 9, 2}, s)
+	})
+}
+
+func TestTopKFunc(t *testing.T) {
+	got := slices.TopKFunc(people, 2, slices.By(func(p person) int { return p.age }))
+	assertEqual(t, []int{40, 30}, slices.Map(got, func(p person) int { return p.age }))
+}
+
+func TestBottomK(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7, 9, 2}
+	t.Run("common", func(t *testing.T) {
+		assertEqual(t, []int{1, 2, 3}, slices.BottomK(s, 3))
+	})
+	t.Run("random", func(t *testing.T) {
+		for _, s := range sortPatterns(500) {
+			want := slices.Sort(s)[:50]
+			assertEqual(t, want, slices.BottomK(s, 50))
+		}
+	})
+	t.Run("k zero", func(t *testing.T) {
+		assertEqual(t, []int{}, slices.BottomK(s, 0))
+	})
+}
+
+func TestBottomKFunc(t *testing.T) {
+	got := slices.BottomKFunc([]string{"ccc", "a", "dddd", "bb"}, 2, func(a, b string) bool { return len(a) < len(b) })
+	assertEqual(t, []string{"a", "bb"}, got)
+}
+
+func TestPartialSort(t *testing.T) {
+	for name, s := range sortPatterns(500) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			got := slices.PartialSort(s, 20)
+			assertEqual(t, slices.Sort(s)[:20], got[:20])
+			assertEqual(t, slices.Sort(s), slices.Sort(got))
+		})
+	}
+	t.Run("k too large", func(t *testing.T) {
+		assertEqual(t, []int{1, 2, 3}, slices.PartialSort([]int{3, 1, 2}, 5))
+	})
+	t.Run("k zero", func(t *testing.T) {
+		assertEqual(t, 3, len(slices.PartialSort([]int{3, 1, 2}, 0)))
+	})
+}
+
+func TestPartialSortFunc(t *testing.T) {
+	s := sortPatterns(500)["random"]
+	got := slices.PartialSortFunc(s, 20, func(a, b int) bool { return a > b })
+	want := slices.Clone(s)
+	sort.Sort(sort.Reverse(sort.IntSlice(want)))
+	assertEqual(t, want[:20], got[:20])
+}
+
+func TestNthElement(t *testing.T) {
+	for name, s := range sortPatterns(500) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			sorted := slices.Sort(s)
+			for _, n := range []int{0, 1, 137, 250, 498, 499} {
+				got := slices.NthElement(s, n)
+				assertEqual(t, sorted[n], got[n])
+				assertEqual(t, sorted[n], slices.Max(got[:n+1]))
+				assertEqual(t, sorted[n], slices.Min(got[n:]))
+			}
+		})
+	}
+}
+
+func TestNthElementFunc(t *testing.T) {
+	s := sortPatterns(500)["random"]
+	greater := func(a, b int) bool { return a > b }
+	got := slices.NthElementFunc(s, 10, greater)
+	assertEqual(t, slices.TopK(s, 11)[10], got[10])
+}
+
+func TestNthElementAdversarial(t *testing.T) {
+	const n, k = 2000, 1000
+	s := selectKiller(n, k)
+	sorted := slices.Sort(s)
+	t.Run("ordered", func(t *testing.T) {
+		got := slices.NthElement(s, k)
+		assertEqual(t, sorted[k], got[k])
+		assertEqual(t, sorted[k], slices.Max(got[:k+1]))
+		assertEqual(t, sorted[k], slices.Min(got[k:]))
+	})
+	t.Run("func", func(t *testing.T) {
+		got := slices.NthElementFunc(s, k, func(a, b int) bool { return a < b })
+		assertEqual(t, sorted[k], got[k])
+		assertEqual(t, sorted[k], slices.Max(got[:k+1]))
+		assertEqual(t, sorted[k], slices.Min(got[k:]))
+	})
+}
+
+// selectKiller returns a permutation of 0 to n-1 on which selecting
+// the item at index k picks a bad pivot for every partition, so that
+// the quick select runs out of depth and falls back to the median of
+// medians. It is built with McIlroy's adversary: items are "gas" until
+// they are compared with other gas, and then frozen to the next least
+// value, which makes the pivot one of the least items.
+func selectKiller(n, k int) []int {
+	gas := n
+	val := make([]int, n)
+	for i := range val {
+		val[i] = gas
+	}
+	solid, candidate := 0, 0
+	freeze := func(i int) {
+		val[i] = solid
+		solid++
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.NthElementFunc(idx, k, func(a, b int) bool {
+		if val[a] == gas && val[b] == gas {
+			if a == candidate {
+				freeze(a)
+			} else {
+				freeze(b)
+			}
+		}
+		if val[a] == gas {
+			candidate = a
+		} else if val[b] == gas {
+			candidate = b
+		}
+		return val[a] < val[b]
+	})
+	for i := range val {
+		if val[i] == gas {
+			freeze(i)
+		}
+	}
+	return val
+}
+
+func TestSelect(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7}
+	assertEqual(t, 1, slices.Select(s, 0))
+	assertEqual(t, 5, slices.Select(s, 2))
+	assertEqual(t, 9, slices.Select(s, 4))
+	assertEqual(t, []int{5, 1, 9, 3, 7}, s)
+}
+
+func TestSelectFunc(t *testing.T) {
+	s := []string{"ccc", "a", "dddd", "bb"}
+	got := slices.SelectFunc(s, 1, func(a, b string) bool { return len(a) < len(b) })
+	assertEqual(t, "bb", got)
+}
+
+func TestMedian(t *testing.T) {
+	t.Run("odd", func(t *testing.T) {
+		assertEqual(t, 3.0, slices.Median([]int{5, 1, 3}))
+	})
+	t.Run("even", func(t *testing.T) {
+		assertEqual(t, 2.5, slices.Median([]float64{4, 1, 3, 2}))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assertEqual(t, 0.0, slices.Median([]uint8{}))
+	})
+}
+
+func TestQuantile(t *testing.T) {
+	s := []int{10, 40, 20, 30}
+	t.Run("linear", func(t *testing.T) {
+		assertEqual(t, 10.0, slices.Quantile(s, 0))
+		assertEqual(t, 17.5, slices.Quantile(s, 0.25))
+		assertEqual(t, 37.0, slices.Quantile(s, 0.9))
+		assertEqual(t, 40.0, slices.Quantile(s, 1))
+	})
+	t.Run("nearest", func(t *testing.T) {
+		assertEqual(t, 20.0, slices.QuantileWith(s, 0.25, slices.Nearest))
+		assertEqual(t, 30.0, slices.QuantileWith(s, 0.5, slices.Nearest))
+		assertEqual(t, 30.0, slices.QuantileWith(s, 0.6, slices.Nearest))
+	})
+	t.Run("lower", func(t *testing.T) {
+		assertEqual(t, 10.0, slices.QuantileWith(s, 0.25, slices.Lower))
+		assertEqual(t, 30.0, slices.QuantileWith(s, 0.9, slices.Lower))
+	})
+	t.Run("higher", func(t *testing.T) {
+		assertEqual(t, 20.0, slices.QuantileWith(s, 0.25, slices.Higher))
+		assertEqual(t, 40.0, slices.QuantileWith(s, 0.9, slices.Higher))
+	})
+	t.Run("large", func(t *testing.T) {
+		s := sortPatterns(1001)["sorted"]
+		assertEqual(t, 250.0, slices.Quantile(s, 0.25))
+		assertEqual(t, 900.0, slices.QuantileWith(s, 0.9, slices.Nearest))
+	})
+	t.Run("out of range", func(t *testing.T) {
+		defer func() {
+			assertEqual(t, "slices: quantile out of range", recover())
+		}()
+		slices.Quantile(s, 1.5)
+	})
+}
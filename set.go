@@ -0,0 +1,171 @@
+package slices
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Set is a collection of distinct items that remembers the order in
+// which the items were added. The zero value is an empty set ready to
+// use.
+type Set[T comparable] struct {
+	index map[T]int
+	items []T
+	dead  []bool
+	holes int
+}
+
+// NewSet creates a new set that contains the given items and returns
+// it.
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{
+		index: make(map[T]int, len(items)),
+		items: make([]T, 0, len(items)),
+		dead:  make([]bool, 0, len(items)),
+	}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the given items to the set. Items that are already in the
+// set keep their position.
+func (s *Set[T]) Add(items ...T) {
+	if s.index == nil {
+		s.index = make(map[T]int, len(items))
+	}
+	for _, item := range items {
+		if _, ok := s.index[item]; ok {
+			continue
+		}
+		s.index[item] = len(s.items)
+		s.items = append(s.items, item)
+		s.dead = append(s.dead, false)
+	}
+}
+
+// Remove removes the given items from the set.
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		i, ok := s.index[item]
+		if !ok {
+			continue
+		}
+		delete(s.index, item)
+		var zero T
+		s.items[i] = zero
+		s.dead[i] = true
+		s.holes++
+	}
+	if s.holes > len(s.items)/2 {
+		s.compact()
+	}
+}
+
+// compact drops the holes left behind by removed items.
+func (s *Set[T]) compact() {
+	items := make([]T, 0, len(s.index))
+	for i := range s.items {
+		if !s.dead[i] {
+			s.index[s.items[i]] = len(items)
+			items = append(items, s.items[i])
+		}
+	}
+	s.items = items
+	s.dead = make([]bool, len(items))
+	s.holes = 0
+}
+
+// Has checks if the given item is in the set.
+func (s *Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.index)
+}
+
+// Slice creates a new slice that contains the items of the set in the
+// order in which they were added and returns it.
+func (s *Set[T]) Slice() []T {
+	output := make([]T, 0, len(s.index))
+	for i := range s.items {
+		if !s.dead[i] {
+			output = append(output, s.items[i])
+		}
+	}
+	return output
+}
+
+// Union creates a new set that contains the items that are in the set
+// or in any of the given sets and returns it.
+func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
+	u := NewSet(s.Slice()...)
+	for _, other := range others {
+		u.Add(other.Slice()...)
+	}
+	return u
+}
+
+// Intersect creates a new set that contains the items of the set that
+// are also in all of the given sets and returns it.
+func (s *Set[T]) Intersect(others ...*Set[T]) *Set[T] {
+	return NewSet(Filter(s.Slice(), func(item T) bool {
+		return Every(others, func(other *Set[T]) bool {
+			return other.Has(item)
+		})
+	})...)
+}
+
+// Difference creates a new set that contains the items of the set
+// that are in none of the given sets and returns it.
+func (s *Set[T]) Difference(others ...*Set[T]) *Set[T] {
+	return NewSet(Filter(s.Slice(), func(item T) bool {
+		return !Some(others, func(other *Set[T]) bool {
+			return other.Has(item)
+		})
+	})...)
+}
+
+// String returns the items of the set in the order in which they
+// were added, formatted like {a b c}.
+func (s Set[T]) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, item := range s.Slice() {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprint(&b, item)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// MarshalJSON encodes the set as a JSON array of its items in the
+// order in which they were added.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON replaces the items of the set with the items of the
+// given JSON array. Duplicate items are dropped.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*s = Set[T]{}
+	s.Add(items...)
+	return nil
+}
+
+// SortSet creates a new slice that contains the items of the given
+// set sorted in ascending order and returns it.
+func SortSet[T Ordered](s *Set[T]) []T {
+	c := s.Slice()
+	sortOrdered(c)
+	return c
+}
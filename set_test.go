@@ -0,0 +1,111 @@
+package slices_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		s := slices.NewSet("foo", "bar", "foo")
+		s.Add("baz", "bar")
+		assertEqual(t, []string{"foo", "bar", "baz"}, s.Slice())
+		assertEqual(t, 3, s.Len())
+	})
+	t.Run("zero value", func(t *testing.T) {
+		var s slices.Set[int]
+		assertEqual(t, false, s.Has(1))
+		assertEqual(t, []int{}, s.Slice())
+		s.Add(1)
+		assertEqual(t, true, s.Has(1))
+	})
+	t.Run("remove", func(t *testing.T) {
+		s := slices.NewSet(1, 2, 3, 4, 5)
+		s.Remove(2, 6)
+		assertEqual(t, []int{1, 3, 4, 5}, s.Slice())
+		s.Remove(1, 3, 5)
+		assertEqual(t, []int{4}, s.Slice())
+		s.Add(1)
+		assertEqual(t, []int{4, 1}, s.Slice())
+		assertEqual(t, false, s.Has(3))
+		assertEqual(t, 2, s.Len())
+	})
+}
+
+func TestSetUnion(t *testing.T) {
+	a := slices.NewSet(1, 2)
+	b := slices.NewSet(3, 2)
+	c := slices.NewSet(4)
+	assertEqual(t, []int{1, 2, 3, 4}, a.Union(b, c).Slice())
+	assertEqual(t, []int{1, 2}, a.Slice())
+}
+
+func TestSetIntersect(t *testing.T) {
+	a := slices.NewSet(1, 2, 3, 4)
+	b := slices.NewSet(4, 3, 2)
+	c := slices.NewSet(2, 4)
+	assertEqual(t, []int{2, 4}, a.Intersect(b, c).Slice())
+}
+
+func TestSetDifference(t *testing.T) {
+	a := slices.NewSet(1, 2, 3, 4)
+	b := slices.NewSet(2)
+	c := slices.NewSet(4, 5)
+	assertEqual(t, []int{1, 3}, a.Difference(b, c).Slice())
+}
+
+func TestSetString(t *testing.T) {
+	assertEqual(t, "{b a c}", slices.NewSet("b", "a", "c").String())
+	assertEqual(t, "{}", slices.NewSet[int]().String())
+	assertEqual(t, "{b a c}", fmt.Sprint(*slices.NewSet("b", "a", "c")))
+	var zero slices.Set[int]
+	assertEqual(t, "{}", fmt.Sprint(zero))
+}
+
+func TestSetJSON(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		b, err := json.Marshal(slices.NewSet(3, 1, 2))
+		assertEqual(t, nil, err)
+		assertEqual(t, "[3,1,2]", string(b))
+	})
+	t.Run("marshal by value", func(t *testing.T) {
+		type post struct {
+			Tags slices.Set[string]
+		}
+		b, err := json.Marshal(post{Tags: *slices.NewSet("go", "json")})
+		assertEqual(t, nil, err)
+		assertEqual(t, `{"Tags":["go","json"]}`, string(b))
+		var p post
+		err = json.Unmarshal(b, &p)
+		assertEqual(t, nil, err)
+		assertEqual(t, []string{"go", "json"}, p.Tags.Slice())
+		b, err = json.Marshal(post{})
+		assertEqual(t, nil, err)
+		assertEqual(t, `{"Tags":[]}`, string(b))
+	})
+	t.Run("unmarshal", func(t *testing.T) {
+		s := slices.NewSet(9)
+		err := json.Unmarshal([]byte("[3,1,3,2]"), s)
+		assertEqual(t, nil, err)
+		assertEqual(t, []int{3, 1, 2}, s.Slice())
+	})
+	t.Run("unmarshal error", func(t *testing.T) {
+		var s slices.Set[int]
+		err := json.Unmarshal([]byte(`["a"]`), &s)
+		assertEqual(t, true, err != nil)
+	})
+}
+
+func TestSortSet(t *testing.T) {
+	s := slices.NewSet("b", "c", "a")
+	assertEqual(t, []string{"a", "b", "c"}, slices.SortSet(s))
+	assertEqual(t, []string{"b", "c", "a"}, s.Slice())
+}
+
+func TestSetWithSlices(t *testing.T) {
+	s := slices.NewSet(slices.Union([]int{1, 2}, []int{2, 3})...)
+	assertEqual(t, []int{2, 3}, slices.Intersection(s.Slice(), []int{3, 2}))
+}
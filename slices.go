@@ -1,7 +1,12 @@
 package slices
 
+// Ordered is a constraint that permits any type whose underlying type
+// supports the < operator. It is equivalent to cmp.Ordered.
 type Ordered interface {
-	int | int32 | int16 | int8 | int64 | uint | uint32 | uint16 | uint8 | uint64 | float32 | float64 | string
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
 }
 
 // Clone creates a clone slice and returns it.
@@ -61,7 +66,8 @@ func Unshift[T any](s []T, item ...T) []T {
 }
 
 // Find finds an item in the given slice that satisfies the given
-// test function.
+// test function. It returns the zero value if no item does; use
+// FindOk to tell that apart from a zero item.
 func Find[T any](s []T, test func(item T) bool) T {
 	for i := range s {
 		if test(s[i]) {
@@ -72,9 +78,57 @@ func Find[T any](s []T, test func(item T) bool) T {
 	return t
 }
 
+// FindOk finds the first item in the given slice that satisfies the
+// given test function and reports whether there is one.
+func FindOk[T any](s []T, test func(item T) bool) (T, bool) {
+	if i := IndexOfFunc(s, test); i >= 0 {
+		return s[i], true
+	}
+	var t T
+	return t, false
+}
+
+// FindLast finds the last item in the given slice that satisfies the
+// given test function. It returns the zero value if no item does.
+func FindLast[T any](s []T, test func(item T) bool) T {
+	t, _ := FindLastOk(s, test)
+	return t
+}
+
+// FindLastOk finds the last item in the given slice that satisfies
+// the given test function and reports whether there is one.
+func FindLastOk[T any](s []T, test func(item T) bool) (T, bool) {
+	if i := LastIndexOfFunc(s, test); i >= 0 {
+		return s[i], true
+	}
+	var t T
+	return t, false
+}
+
+// FindAll finds the indices of all items in the given slice that
+// satisfy the given test function, in ascending order.
+func FindAll[T any](s []T, test func(item T) bool) []int {
+	output := make([]int, 0)
+	for i := range s {
+		if test(s[i]) {
+			output = append(output, i)
+		}
+	}
+	return output
+}
+
 // IndexOf finds the index of the first item in the given slice that
-// satisfies the given test function.
-func IndexOf[T Ordered](s []T, item T) int {
+// is equal to the given item. A floating point NaN is considered equal
+// to any other NaN.
+func IndexOf[T comparable](s []T, item T) int {
+	if item != item && isFloat[T]() {
+		for i := range s {
+			if s[i] != s[i] {
+				return i
+			}
+		}
+		return -1
+	}
 	for i := range s {
 		if s[i] == item {
 			return i
@@ -83,6 +137,23 @@ func IndexOf[T Ordered](s []T, item T) int {
 	return -1
 }
 
+// LastIndexOf finds the index of the last item in the given slice
+// that is equal to the given item. A floating point NaN is considered
+// equal to any other NaN.
+func LastIndexOf[T comparable](s []T, item T) int {
+	if item != item && isFloat[T]() {
+		return LastIndexOfFunc(s, func(x T) bool {
+			return x != x
+		})
+	}
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == item {
+			return i
+		}
+	}
+	return -1
+}
+
 // IndexOfFunc finds the index of the first item in the given slice
 // that satisfies the given test function.
 func IndexOfFunc[T any](s []T, test func(item T) bool) int {
@@ -94,6 +165,17 @@ func IndexOfFunc[T any](s []T, test func(item T) bool) int {
 	return -1
 }
 
+// LastIndexOfFunc finds the index of the last item in the given slice
+// that satisfies the given test function.
+func LastIndexOfFunc[T any](s []T, test func(item T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if test(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Some checks is any of the items in the given slice satisfies the
 // given test function.
 func Some[T any](s []T, test func(item T) bool) bool {
@@ -101,40 +183,42 @@ func Some[T any](s []T, test func(item T) bool) bool {
 }
 
 // Contains checks if any of the items in the given slice are equal
-// to the given item.
-func Contains[T Ordered](s []T, item T) bool {
-	for i := range s {
-		if s[i] == item {
-			return true
-		}
-	}
-	return false
+// to the given item. A floating point NaN is considered equal to any
+// other NaN.
+func Contains[T comparable](s []T, item T) bool {
+	return IndexOf(s, item) >= 0
 }
 
-// Max returns the max item in the given slice.
+// Max returns the max item in the given slice. Floating point values
+// are compared in the total order of Compare, so NaNs are ignored
+// unless there is nothing else.
 func Max[T Ordered](s []T) T {
 	if len(s) == 0 {
 		var t T
 		return t
 	}
+	less := ascending[T]()
 	max := s[0]
 	for i := range s {
-		if s[i] > max {
+		if less(max, s[i]) {
 			max = s[i]
 		}
 	}
 	return max
 }
 
-// Min returns the min item in the given slice.
+// Min returns the min item in the given slice. Floating point values
+// are compared in the total order of Compare, so the min is NaN if
+// there are any NaNs.
 func Min[T Ordered](s []T) T {
 	if len(s) == 0 {
 		var t T
 		return t
 	}
+	less := ascending[T]()
 	min := s[0]
 	for i := range s {
-		if s[i] < min {
+		if less(s[i], min) {
 			min = s[i]
 		}
 	}
@@ -144,6 +228,9 @@ func Min[T Ordered](s []T) T {
 // MaxFunc returns the max item in the given slice according to the
 // given less func.
 func MaxFunc[T any](s []T, less func(T, T) bool) T {
+	if debug {
+		mustCheckLess(s, less)
+	}
 	if len(s) == 0 {
 		var t T
 		return t
@@ -160,6 +247,9 @@ func MaxFunc[T any](s []T, less func(T, T) bool) T {
 // MinFunc returns the min item in the given slice according to the
 // given less func.
 func MinFunc[T any](s []T, less func(T, T) bool) T {
+	if debug {
+		mustCheckLess(s, less)
+	}
 	if len(s) == 0 {
 		var t T
 		return t
@@ -173,6 +263,18 @@ func MinFunc[T any](s []T, less func(T, T) bool) T {
 	return min
 }
 
+// MaxCmp returns the max item in the given slice according to the
+// given compare func.
+func MaxCmp[T any](s []T, cmp func(a, b T) int) T {
+	return MaxFunc(s, LessFromCmp(cmp))
+}
+
+// MinCmp returns the min item in the given slice according to the
+// given compare func.
+func MinCmp[T any](s []T, cmp func(a, b T) int) T {
+	return MinFunc(s, LessFromCmp(cmp))
+}
+
 // Every checks is every item in the given slice satisfies the
 // given test function.
 func Every[T any](s []T, test func(item T) bool) bool {
@@ -185,22 +287,99 @@ func Every[T any](s []T, test func(item T) bool) bool {
 }
 
 // SortFunc creates a new slice that is sorted in ascending order
-// according the the given less func and returns it. The given slice
-// is not changed.
+// according the the given less func and returns it. The sort is not
+// guaranteed to be stable and runs in O(n log n) time in the worst
+// case. The given slice is not changed.
 func SortFunc[T any](s []T, less func(a T, b T) bool) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
 	c := Clone(s)
-	quickSortFunc(c, 0, len(c)-1, less)
+	introSortFunc(c, less)
+	return c
+}
+
+// SortCmp creates a new slice that is sorted in ascending order
+// according the the given compare func and returns it. The sort is
+// not guaranteed to be stable and runs in O(n log n) time in the worst
+// case. The given slice is not changed.
+func SortCmp[T any](s []T, cmp func(a, b T) int) []T {
+	less := LessFromCmp(cmp)
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	introSortFunc(c, less)
 	return c
 }
 
 // Sort creates a new slice that is sorted in ascending order. The
-// given slice is not changed.
+// sort is not guaranteed to be stable and runs in O(n log n) time in
+// the worst case. Long slices of integers and floating point numbers
+// are radix sorted in linear time instead. Floating point numbers are
+// sorted in the total order of Compare, with NaNs first and -0 before
+// +0. The given slice is not changed.
 func Sort[T Ordered](s []T) []T {
 	c := Clone(s)
-	quickSort(c, 0, len(s)-1)
+	sortOrdered(c)
+	return c
+}
+
+// SortStableFunc creates a new slice that is sorted in ascending
+// order according the the given less func and returns it. Items that
+// are equal keep their original order. The given slice is not
+// changed.
+func SortStableFunc[T any](s []T, less func(a T, b T) bool) []T {
+	if debug {
+		mustCheckLess(s, less)
+	}
+	c := Clone(s)
+	mergeSortFunc(c, less)
+	return c
+}
+
+// SortStable creates a new slice that is sorted in ascending order.
+// Items that are equal keep their original order. The given slice is
+// not changed.
+func SortStable[T Ordered](s []T) []T {
+	c := Clone(s)
+	sortStableOrdered(c)
 	return c
 }
 
+// SortBy creates a new slice that is sorted in ascending order of the
+// keys returned by the given key func and returns it. The key func is
+// called exactly once for each item. The sort is not guaranteed to be
+// stable. The given slice is not changed.
+func SortBy[T any, K Ordered](s []T, key func(item T) K) []T {
+	return sortByKey(s, key, false, ascending[K]())
+}
+
+// SortByDescending creates a new slice that is sorted in descending
+// order of the keys returned by the given key func and returns it.
+// The key func is called exactly once for each item. The sort is not
+// guaranteed to be stable. The given slice is not changed.
+func SortByDescending[T any, K Ordered](s []T, key func(item T) K) []T {
+	return sortByKey(s, key, false, descending[K]())
+}
+
+// SortStableBy creates a new slice that is sorted in ascending order
+// of the keys returned by the given key func and returns it. The key
+// func is called exactly once for each item. Items with equal keys
+// keep their original order. The given slice is not changed.
+func SortStableBy[T any, K Ordered](s []T, key func(item T) K) []T {
+	return sortByKey(s, key, true, ascending[K]())
+}
+
+// SortStableByDescending creates a new slice that is sorted in
+// descending order of the keys returned by the given key func and
+// returns it. The key func is called exactly once for each item.
+// Items with equal keys keep their original order. The given slice is
+// not changed.
+func SortStableByDescending[T any, K Ordered](s []T, key func(item T) K) []T {
+	return sortByKey(s, key, true, descending[K]())
+}
+
 // Filter creates a new slice that contains items from the given
 // slice that satisfy the given test function and returns it. The
 // given slice is not changed.
@@ -238,8 +417,9 @@ func Reduce[T any, K any](s []T, f func(prev K, cur T) K) K {
 
 // Intersection creates a new slice that contains the intersection of
 // all the given slices. The given slices are not changed. All items
-// in the returned slice are distinct.
-func Intersection[T Ordered](s ...[]T) []T {
+// in the returned slice are distinct and in the order of their first
+// occurrence in the first slice.
+func Intersection[T comparable](s ...[]T) []T {
 	if len(s) == 0 {
 		return []T{}
 	}
@@ -252,9 +432,10 @@ func Intersection[T Ordered](s ...[]T) []T {
 		}
 	}
 	result := make([]T, 0, len(hash))
-	for k := range hash {
+	for _, k := range s[0] {
 		if hash[k] == len(s) {
 			result = append(result, k)
+			hash[k] = 0
 		}
 	}
 	return result
@@ -262,41 +443,364 @@ func Intersection[T Ordered](s ...[]T) []T {
 
 // Union creates a new slice that contains the union of all the given
 // slices. The given slices are not changed. All items in the
-// returned slice are distinct.
-func Union[T Ordered](s ...[]T) []T {
+// returned slice are distinct and in the order of their first
+// occurrence.
+func Union[T comparable](s ...[]T) []T {
 	if len(s) == 0 {
 		return []T{}
 	}
 	hash := make(map[T]struct{})
+	output := make([]T, 0)
 	for i := range s {
-		for j := range s[i] {
-			hash[s[i][j]] = struct{}{}
+		for _, k := range s[i] {
+			if _, ok := hash[k]; !ok {
+				hash[k] = struct{}{}
+				output = append(output, k)
+			}
 		}
 	}
-	output := make([]T, len(hash))
-	i := 0
-	for k := range hash {
-		output[i] = k
-		i++
-	}
 	return output
 }
 
 // Distinct creates a new slice that contains all of the distinct
-// items from the given slices without duplicates.
-func Distinct[T Ordered](s []T) []T {
+// items from the given slices without duplicates. The items are in
+// the order of their first occurrence.
+func Distinct[T comparable](s []T) []T {
 	if len(s) == 0 {
 		return []T{}
 	}
 	hash := make(map[T]struct{})
+	output := make([]T, 0)
+	for _, k := range s {
+		if _, ok := hash[k]; !ok {
+			hash[k] = struct{}{}
+			output = append(output, k)
+		}
+	}
+	return output
+}
+
+// Difference creates a new slice that contains the items of the first
+// slice that occur in none of the other given slices. The given slices
+// are not changed. All items in the returned slice are distinct and in
+// the order of their first occurrence in the first slice.
+func Difference[T comparable](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	hash := make(map[T]bool)
+	for _, other := range s[1:] {
+		for j := range other {
+			hash[other[j]] = true
+		}
+	}
+	output := make([]T, 0, len(s[0]))
+	for _, k := range s[0] {
+		if !hash[k] {
+			output = append(output, k)
+			hash[k] = true
+		}
+	}
+	return output
+}
+
+// occurrence counts the slices an item occurs in and remembers the
+// last one, so that each slice is counted once.
+type occurrence struct {
+	count int
+	last  int
+}
+
+// SymmetricDifference creates a new slice that contains the items
+// that occur in an odd number of the given slices. For two slices
+// these are the items that occur in only one of them. The given slices
+// are not changed. All items in the returned slice are distinct and in
+// the order of their first occurrence.
+func SymmetricDifference[T comparable](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	hash := make(map[T]occurrence)
+	for i := range s {
+		for _, k := range s[i] {
+			o, ok := hash[k]
+			if !ok || o.last != i {
+				hash[k] = occurrence{count: o.count + 1, last: i}
+			}
+		}
+	}
+	output := make([]T, 0, len(hash))
+	for i := range s {
+		for _, k := range s[i] {
+			if o, ok := hash[k]; ok {
+				if o.count%2 == 1 {
+					output = append(output, k)
+				}
+				delete(hash, k)
+			}
+		}
+	}
+	return output
+}
+
+// IsSubset checks if every item in the first given slice also occurs
+// in the second given slice.
+func IsSubset[T comparable](a []T, b []T) bool {
+	hash := make(map[T]struct{}, len(b))
+	for i := range b {
+		hash[b[i]] = struct{}{}
+	}
+	for i := range a {
+		if _, ok := hash[a[i]]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset checks if every item in the second given slice also
+// occurs in the first given slice.
+func IsSuperset[T comparable](a []T, b []T) bool {
+	return IsSubset(b, a)
+}
+
+// IsDisjoint checks if no item occurs in more than one of the given
+// slices.
+func IsDisjoint[T comparable](s ...[]T) bool {
+	hash := make(map[T]int)
 	for i := range s {
-		hash[s[i]] = struct{}{}
+		for _, k := range s[i] {
+			if j, ok := hash[k]; ok && j != i {
+				return false
+			}
+			hash[k] = i
+		}
+	}
+	return true
+}
+
+// MultisetDifference creates a new slice that contains the items of
+// the first slice, where every occurrence of an item in the other
+// given slices cancels one of its occurrences in the first slice. The
+// given slices are not changed. The earliest occurrences are kept and
+// the items are in the order of the first slice.
+func MultisetDifference[T comparable](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	counts := make(map[T]int)
+	for _, k := range s[0] {
+		counts[k]++
+	}
+	for _, other := range s[1:] {
+		for _, k := range other {
+			counts[k]--
+		}
+	}
+	output := make([]T, 0, len(s[0]))
+	for _, k := range s[0] {
+		if counts[k] > 0 {
+			output = append(output, k)
+			counts[k]--
+		}
+	}
+	return output
+}
+
+// MultisetIntersection creates a new slice in which every item occurs
+// as many times as it occurs in the given slice that contains it the
+// fewest times. The given slices are not changed. The earliest
+// occurrences are kept and the items are in the order of the first
+// slice.
+func MultisetIntersection[T comparable](s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	counts := make(map[T]int)
+	for _, k := range s[0] {
+		counts[k]++
+	}
+	for _, other := range s[1:] {
+		c := make(map[T]int, len(counts))
+		for _, k := range other {
+			if c[k] < counts[k] {
+				c[k]++
+			}
+		}
+		counts = c
+	}
+	output := make([]T, 0, len(s[0]))
+	for _, k := range s[0] {
+		if counts[k] > 0 {
+			output = append(output, k)
+			counts[k]--
+		}
+	}
+	return output
+}
+
+// MultisetUnion creates a new slice in which every item occurs as many
+// times as it occurs in the given slice that contains it the most
+// times. The given slices are not changed. The earliest occurrences
+// are kept and the items are in the order of their occurrence.
+func MultisetUnion[T comparable](s ...[]T) []T {
+	counts := make(map[T]int)
+	total := 0
+	for i := range s {
+		c := make(map[T]int)
+		for _, k := range s[i] {
+			c[k]++
+			if c[k] > counts[k] {
+				counts[k] = c[k]
+				total++
+			}
+		}
+	}
+	output := make([]T, 0, total)
+	for i := range s {
+		for _, k := range s[i] {
+			if counts[k] > 0 {
+				output = append(output, k)
+				counts[k]--
+			}
+		}
+	}
+	return output
+}
+
+// IsSubMultiset checks if every item in the first given slice occurs
+// at least as many times in the second given slice.
+func IsSubMultiset[T comparable](a []T, b []T) bool {
+	counts := make(map[T]int, len(b))
+	for i := range b {
+		counts[b[i]]++
 	}
-	output := make([]T, len(hash))
-	i := 0
-	for k := range hash {
-		output[i] = k
-		i++
+	for i := range a {
+		counts[a[i]]--
+		if counts[a[i]] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DistinctBy creates a new slice that contains the first item for
+// each distinct key returned by the given key func. The items are in
+// the order of their first occurrence. The given slice is not changed.
+func DistinctBy[T any, K comparable](s []T, key func(item T) K) []T {
+	hash := make(map[K]struct{})
+	output := make([]T, 0)
+	for i := range s {
+		k := key(s[i])
+		if _, ok := hash[k]; !ok {
+			hash[k] = struct{}{}
+			output = append(output, s[i])
+		}
+	}
+	return output
+}
+
+// UnionBy creates a new slice that contains the first item for each
+// distinct key returned by the given key func across all the given
+// slices. The given slices are not changed. The items are in the order
+// of their first occurrence.
+func UnionBy[T any, K comparable](key func(item T) K, s ...[]T) []T {
+	hash := make(map[K]struct{})
+	output := make([]T, 0)
+	for i := range s {
+		for j := range s[i] {
+			k := key(s[i][j])
+			if _, ok := hash[k]; !ok {
+				hash[k] = struct{}{}
+				output = append(output, s[i][j])
+			}
+		}
+	}
+	return output
+}
+
+// IntersectionBy creates a new slice that contains the items of the
+// first slice whose keys, returned by the given key func, occur in all
+// the given slices. The given slices are not changed. Only the first
+// item for each key is kept and the items are in the order of their
+// first occurrence in the first slice.
+func IntersectionBy[T any, K comparable](key func(item T) K, s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	hash := make(map[K]int)
+	for i := range s {
+		for j := range s[i] {
+			k := key(s[i][j])
+			if hash[k] == i {
+				hash[k]++
+			}
+		}
+	}
+	output := make([]T, 0)
+	for _, item := range s[0] {
+		k := key(item)
+		if hash[k] == len(s) {
+			output = append(output, item)
+			hash[k] = 0
+		}
+	}
+	return output
+}
+
+// DifferenceBy creates a new slice that contains the items of the
+// first slice whose keys, returned by the given key func, occur in
+// none of the other given slices. The given slices are not changed.
+// Only the first item for each key is kept and the items are in the
+// order of their first occurrence in the first slice.
+func DifferenceBy[T any, K comparable](key func(item T) K, s ...[]T) []T {
+	if len(s) == 0 {
+		return []T{}
+	}
+	hash := make(map[K]bool)
+	for _, other := range s[1:] {
+		for j := range other {
+			hash[key(other[j])] = true
+		}
+	}
+	output := make([]T, 0)
+	for _, item := range s[0] {
+		k := key(item)
+		if !hash[k] {
+			output = append(output, item)
+			hash[k] = true
+		}
+	}
+	return output
+}
+
+// SymmetricDifferenceBy creates a new slice that contains the first
+// item for each key, returned by the given key func, that occurs in an
+// odd number of the given slices. For two slices these are the items
+// whose keys occur in only one of them. The given slices are not
+// changed. The items are in the order of their first occurrence.
+func SymmetricDifferenceBy[T any, K comparable](key func(item T) K, s ...[]T) []T {
+	hash := make(map[K]occurrence)
+	for i := range s {
+		for j := range s[i] {
+			k := key(s[i][j])
+			o, ok := hash[k]
+			if !ok || o.last != i {
+				hash[k] = occurrence{count: o.count + 1, last: i}
+			}
+		}
+	}
+	output := make([]T, 0)
+	for i := range s {
+		for _, item := range s[i] {
+			k := key(item)
+			if o, ok := hash[k]; ok {
+				if o.count%2 == 1 {
+					output = append(output, item)
+				}
+				delete(hash, k)
+			}
+		}
 	}
 	return output
 }
@@ -1,6 +1,7 @@
 package slices_test
 
 import (
+	"math"
 	"math/rand"
 	"reflect"
 	"sort"
@@ -91,6 +92,62 @@ func TestFind(t *testing.T) {
 	})
 }
 
+func TestFindOk(t *testing.T) {
+	s := []int{0, 1, 2}
+	t.Run("found zero", func(t *testing.T) {
+		got, ok := slices.FindOk(s, func(item int) bool { return item < 1 })
+		assertEqual(t, 0, got)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, ok := slices.FindOk(s, func(item int) bool { return item > 2 })
+		assertEqual(t, 0, got)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestFindLast(t *testing.T) {
+	s := []string{"foo", "bar", "baz"}
+	t.Run("found", func(t *testing.T) {
+		got := slices.FindLast(s, func(item string) bool { return item[0] == 'b' })
+		want := "baz"
+		assertEqual(t, want, got)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got := slices.FindLast(s, func(item string) bool { return item == "qux" })
+		want := ""
+		assertEqual(t, want, got)
+	})
+}
+
+func TestFindLastOk(t *testing.T) {
+	s := []int{1, 0, 2}
+	t.Run("found zero", func(t *testing.T) {
+		got, ok := slices.FindLastOk(s, func(item int) bool { return item < 2 })
+		assertEqual(t, 0, got)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, ok := slices.FindLastOk([]int{}, func(item int) bool { return true })
+		assertEqual(t, 0, got)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+	t.Run("found", func(t *testing.T) {
+		got := slices.FindAll(s, func(item int) bool { return item%2 == 0 })
+		want := []int{1, 3, 5}
+		assertEqual(t, want, got)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got := slices.FindAll(s, func(item int) bool { return item > 6 })
+		want := []int{}
+		assertEqual(t, want, got)
+	})
+}
+
 func TestIndexOf(t *testing.T) {
 	s := []string{"foo"}
 	t.Run("found", func(t *testing.T) {
@@ -105,6 +162,25 @@ func TestIndexOf(t *testing.T) {
 	})
 }
 
+func TestLastIndexOf(t *testing.T) {
+	s := []string{"foo", "bar", "foo"}
+	t.Run("found", func(t *testing.T) {
+		got := slices.LastIndexOf(s, "foo")
+		want := 2
+		assertEqual(t, want, got)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got := slices.LastIndexOf(s, "baz")
+		want := -1
+		assertEqual(t, want, got)
+	})
+	t.Run("NaN", func(t *testing.T) {
+		got := slices.LastIndexOf([]float64{math.NaN(), 1, math.NaN(), 2}, math.NaN())
+		want := 2
+		assertEqual(t, want, got)
+	})
+}
+
 func TestIndexOfFunc(t *testing.T) {
 	s := []string{"foo"}
 	t.Run("found", func(t *testing.T) {
@@ -119,6 +195,20 @@ func TestIndexOfFunc(t *testing.T) {
 	})
 }
 
+func TestLastIndexOfFunc(t *testing.T) {
+	s := []string{"foo", "bar", "baz"}
+	t.Run("found", func(t *testing.T) {
+		got := slices.LastIndexOfFunc(s, func(item string) bool { return item[0] == 'b' })
+		want := 2
+		assertEqual(t, want, got)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got := slices.LastIndexOfFunc(s, func(item string) bool { return item == "qux" })
+		want := -1
+		assertEqual(t, want, got)
+	})
+}
+
 func TestFilter(t *testing.T) {
 	s := []string{"foo", "bar"}
 	got := slices.Filter(s, func(item string) bool {
@@ -235,30 +325,128 @@ func TestReduce(t *testing.T) {
 	assertEqual(t, want, got)
 }
 
+func sortPatterns(n int) map[string][]int {
+	patterns := map[string][]int{
+		"random":   make([]int, n),
+		"sorted":   make([]int, n),
+		"reversed": make([]int, n),
+		"equal":    make([]int, n),
+		"sawtooth": make([]int, n),
+		"few":      make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		patterns["random"][i] = rand.Int()
+		patterns["sorted"][i] = i
+		patterns["reversed"][i] = n - i
+		patterns["equal"][i] = 7
+		patterns["sawtooth"][i] = i % 37
+		patterns["few"][i] = rand.Intn(4)
+	}
+	return patterns
+}
+
 func TestSortFunc(t *testing.T) {
-	var s []int
-	for i := 0; i < 1000; i++ {
-		s = append(s, rand.Int())
+	for name, s := range sortPatterns(1000) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			got := slices.SortFunc(s, func(a int, b int) bool {
+				return a < b
+			})
+			want := slices.Clone(s)
+			sort.Slice(want, func(i, j int) bool {
+				return want[i] < want[j]
+			})
+			assertEqual(t, want, got)
+		})
 	}
-	got := slices.SortFunc(s, func(a int, b int) bool {
-		return a < b
-	})
-	want := slices.Clone(s)
-	sort.Slice(want, func(i, j int) bool {
-		return want[i] < want[j]
-	})
-	assertEqual(t, want, got)
 }
 
 func TestSort(t *testing.T) {
-	var s []int
-	for i := 0; i < 1000; i++ {
-		s = append(s, rand.Int())
+	for name, s := range sortPatterns(1000) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			got := slices.Sort(s)
+			want := slices.Clone(s)
+			sort.Ints(want)
+			assertEqual(t, want, got)
+		})
 	}
-	got := slices.Sort(s)
-	want := slices.Clone(s)
-	sort.Ints(want)
-	assertEqual(t, want, got)
+	t.Run("unchanged", func(t *testing.T) {
+		s := []int{3, 1, 2}
+		_ = slices.Sort(s)
+		assertEqual(t, []int{3, 1, 2}, s)
+	})
+}
+
+func TestSortStableFunc(t *testing.T) {
+	type item struct {
+		key int
+		pos int
+	}
+	for name, keys := range sortPatterns(1000) {
+		keys := keys
+		t.Run(name, func(t *testing.T) {
+			s := make([]item, len(keys))
+			for i := range keys {
+				s[i] = item{key: keys[i] % 50, pos: i}
+			}
+			got := slices.SortStableFunc(s, func(a, b item) bool {
+				return a.key < b.key
+			})
+			want := slices.Clone(s)
+			sort.SliceStable(want, func(i, j int) bool {
+				return want[i].key < want[j].key
+			})
+			assertEqual(t, want, got)
+		})
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	for name, s := range sortPatterns(1000) {
+		s := s
+		t.Run(name, func(t *testing.T) {
+			got := slices.SortStable(s)
+			want := slices.Clone(s)
+			sort.Ints(want)
+			assertEqual(t, want, got)
+		})
+	}
+	t.Run("unchanged", func(t *testing.T) {
+		s := []int{3, 1, 2}
+		_ = slices.SortStable(s)
+		assertEqual(t, []int{3, 1, 2}, s)
+	})
+}
+
+func TestSortBy(t *testing.T) {
+	s := []string{"ccc", "a", "bb", "dddd", "ee"}
+	t.Run("ascending", func(t *testing.T) {
+		calls := 0
+		got := slices.SortBy(s, func(item string) int {
+			calls++
+			return len(item)
+		})
+		assertEqual(t, []int{1, 2, 2, 3, 4}, slices.Map(got, func(item string) int { return len(item) }))
+		assertEqual(t, len(s), calls)
+	})
+	t.Run("descending", func(t *testing.T) {
+		got := slices.SortByDescending(s, func(item string) int { return len(item) })
+		assertEqual(t, []int{4, 3, 2, 2, 1}, slices.Map(got, func(item string) int { return len(item) }))
+	})
+	t.Run("stable", func(t *testing.T) {
+		got := slices.SortStableBy(s, func(item string) int { return len(item) })
+		want := []string{"a", "bb", "ee", "ccc", "dddd"}
+		assertEqual(t, want, got)
+	})
+	t.Run("stable descending", func(t *testing.T) {
+		got := slices.SortStableByDescending(s, func(item string) int { return len(item) })
+		want := []string{"dddd", "ccc", "bb", "ee", "a"}
+		assertEqual(t, want, got)
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		assertEqual(t, []string{"ccc", "a", "bb", "dddd", "ee"}, s)
+	})
 }
 
 func TestIntersection(t *testing.T) {
@@ -289,16 +477,36 @@ func TestIntersection(t *testing.T) {
 		got := slices.Sort(slices.Intersection(a, b, c))
 		assertEqual(t, want, got)
 	})
+	t.Run("order", func(t *testing.T) {
+		a := []string{"c", "a", "b", "c", "d", "a"}
+		b := []string{"a", "d", "c", "a"}
+		want := []string{"c", "a", "d"}
+		got := slices.Intersection(a, b)
+		assertEqual(t, want, got)
+	})
 }
 
 func TestUnion(t *testing.T) {
 	t.Run("common", func(t *testing.T) {
 		a := []string{"foo", "bar"}
 		b := []string{"bar", "baz"}
-		want := slices.Sort([]string{"foo", "bar", "baz"})
-		got := slices.Sort(slices.Union(a, b))
+		want := []string{"foo", "bar", "baz"}
+		got := slices.Union(a, b)
+		assertEqual(t, want, got)
+	})
+	t.Run("dupes in slice", func(t *testing.T) {
+		a := []string{"foo", "foo", "bar"}
+		b := []string{"baz", "bar", "baz", "foo", "qux"}
+		want := []string{"foo", "bar", "baz", "qux"}
+		got := slices.Union(a, b)
 		assertEqual(t, want, got)
 	})
+	t.Run("nan", func(t *testing.T) {
+		got := slices.Union([]float64{math.NaN()}, []float64{2})
+		if len(got) != 2 || !math.IsNaN(got[0]) || got[1] != 2 {
+			t.Fatalf("want [NaN 2]; got %v", got)
+		}
+	})
 	t.Run("empty slice", func(t *testing.T) {
 		var s [][]string
 		got := slices.Union(s...)
@@ -308,14 +516,258 @@ func TestUnion(t *testing.T) {
 
 func TestDistinct(t *testing.T) {
 	t.Run("common", func(t *testing.T) {
-		a := []string{"foo", "bar", "baz", "foo"}
-		want := slices.Sort([]string{"foo", "bar", "baz"})
-		got := slices.Sort(slices.Distinct(a))
+		a := []string{"foo", "bar", "baz", "foo", "bar", "qux"}
+		want := []string{"foo", "bar", "baz", "qux"}
+		got := slices.Distinct(a)
 		assertEqual(t, want, got)
 	})
+	t.Run("nan", func(t *testing.T) {
+		got := slices.Distinct([]float64{math.NaN(), 1, 1})
+		if len(got) != 2 || !math.IsNaN(got[0]) || got[1] != 1 {
+			t.Fatalf("want [NaN 1]; got %v", got)
+		}
+	})
 	t.Run("empty slice", func(t *testing.T) {
 		var s []string
 		got := slices.Distinct(s)
 		assertEqual(t, got, []string{})
 	})
 }
+
+type userID int64
+
+type point struct {
+	x, y int
+}
+
+func TestNamedTypes(t *testing.T) {
+	s := []userID{3, 1, 2}
+	t.Run("sort", func(t *testing.T) {
+		assertEqual(t, []userID{1, 2, 3}, slices.Sort(s))
+	})
+	t.Run("max", func(t *testing.T) {
+		assertEqual(t, userID(3), slices.Max(s))
+	})
+	t.Run("min", func(t *testing.T) {
+		assertEqual(t, userID(1), slices.Min(s))
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		assertEqual(t, []uintptr{1, 2}, slices.Sort([]uintptr{2, 1}))
+	})
+}
+
+func TestComparable(t *testing.T) {
+	a, b := &point{1, 2}, &point{1, 2}
+	t.Run("structs", func(t *testing.T) {
+		s := []point{{1, 2}, {3, 4}, {1, 2}}
+		assertEqual(t, true, slices.Contains(s, point{3, 4}))
+		assertEqual(t, 2, len(slices.Distinct(s)))
+		assertEqual(t, []point{{3, 4}}, slices.Intersection(s, []point{{3, 4}}))
+	})
+	t.Run("pointers", func(t *testing.T) {
+		s := []*point{a}
+		assertEqual(t, 0, slices.IndexOf(s, a))
+		assertEqual(t, -1, slices.IndexOf(s, b))
+		assertEqual(t, 2, len(slices.Union(s, []*point{a, b})))
+	})
+	t.Run("bools", func(t *testing.T) {
+		assertEqual(t, false, slices.Contains([]bool{true}, false))
+	})
+}
+
+type record struct {
+	id   int
+	name string
+}
+
+func recordID(r record) int {
+	return r.id
+}
+
+func TestDistinctBy(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		s := []record{{2, "a"}, {1, "b"}, {2, "c"}, {3, "d"}, {1, "e"}}
+		want := []record{{2, "a"}, {1, "b"}, {3, "d"}}
+		got := slices.DistinctBy(s, recordID)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.DistinctBy(nil, recordID)
+		assertEqual(t, []record{}, got)
+	})
+}
+
+func TestUnionBy(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []record{{1, "a"}, {2, "b"}, {1, "c"}}
+		b := []record{{3, "d"}, {2, "e"}, {4, "f"}}
+		want := []record{{1, "a"}, {2, "b"}, {3, "d"}, {4, "f"}}
+		got := slices.UnionBy(recordID, a, b)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.UnionBy(recordID)
+		assertEqual(t, []record{}, got)
+	})
+}
+
+func TestIntersectionBy(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []record{{3, "a"}, {1, "b"}, {2, "c"}, {3, "d"}}
+		b := []record{{2, "e"}, {3, "f"}, {3, "g"}}
+		c := []record{{3, "h"}, {2, "i"}, {1, "j"}}
+		want := []record{{3, "a"}, {2, "c"}}
+		got := slices.IntersectionBy(recordID, a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.IntersectionBy(recordID)
+		assertEqual(t, []record{}, got)
+	})
+}
+
+func TestDifferenceBy(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []record{{1, "a"}, {2, "b"}, {3, "c"}, {1, "d"}, {4, "e"}}
+		b := []record{{2, "f"}}
+		c := []record{{4, "g"}}
+		want := []record{{1, "a"}, {3, "c"}}
+		got := slices.DifferenceBy(recordID, a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.DifferenceBy(recordID)
+		assertEqual(t, []record{}, got)
+	})
+}
+
+func TestSymmetricDifferenceBy(t *testing.T) {
+	t.Run("two slices", func(t *testing.T) {
+		a := []record{{1, "a"}, {2, "b"}, {1, "c"}}
+		b := []record{{3, "d"}, {2, "e"}, {3, "f"}}
+		want := []record{{1, "a"}, {3, "d"}}
+		got := slices.SymmetricDifferenceBy(recordID, a, b)
+		assertEqual(t, want, got)
+	})
+	t.Run("three slices", func(t *testing.T) {
+		a := []record{{1, "a"}, {2, "b"}}
+		b := []record{{1, "c"}, {3, "d"}}
+		c := []record{{1, "e"}, {3, "f"}}
+		want := []record{{1, "a"}, {2, "b"}}
+		got := slices.SymmetricDifferenceBy(recordID, a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.SymmetricDifferenceBy(recordID)
+		assertEqual(t, []record{}, got)
+	})
+}
+
+func TestDifference(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []string{"foo", "bar", "baz", "foo", "qux"}
+		b := []string{"bar"}
+		c := []string{"qux", "quux"}
+		want := []string{"foo", "baz"}
+		got := slices.Difference(a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("single slice", func(t *testing.T) {
+		got := slices.Difference([]string{"foo", "foo"})
+		assertEqual(t, []string{"foo"}, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		var s [][]string
+		got := slices.Difference(s...)
+		assertEqual(t, []string{}, got)
+	})
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	t.Run("two slices", func(t *testing.T) {
+		a := []string{"foo", "bar", "foo"}
+		b := []string{"baz", "bar", "qux"}
+		want := []string{"foo", "baz", "qux"}
+		got := slices.SymmetricDifference(a, b)
+		assertEqual(t, want, got)
+	})
+	t.Run("three slices", func(t *testing.T) {
+		a := []string{"foo", "bar"}
+		b := []string{"foo", "baz"}
+		c := []string{"foo", "baz"}
+		want := []string{"foo", "bar"}
+		got := slices.SymmetricDifference(a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		var s [][]string
+		got := slices.SymmetricDifference(s...)
+		assertEqual(t, []string{}, got)
+	})
+}
+
+func TestIsSubset(t *testing.T) {
+	assertEqual(t, true, slices.IsSubset([]int{1, 2, 1}, []int{2, 3, 1}))
+	assertEqual(t, false, slices.IsSubset([]int{1, 4}, []int{2, 3, 1}))
+	assertEqual(t, true, slices.IsSubset(nil, []int{1}))
+}
+
+func TestIsSuperset(t *testing.T) {
+	assertEqual(t, true, slices.IsSuperset([]int{2, 3, 1}, []int{1, 2, 1}))
+	assertEqual(t, false, slices.IsSuperset([]int{2, 3, 1}, []int{1, 4}))
+}
+
+func TestIsDisjoint(t *testing.T) {
+	assertEqual(t, true, slices.IsDisjoint([]int{1, 1, 2}, []int{3, 4}, []int{5}))
+	assertEqual(t, false, slices.IsDisjoint([]int{1, 2}, []int{3, 4}, []int{4}))
+	assertEqual(t, true, slices.IsDisjoint[int]())
+}
+
+func TestMultisetDifference(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []int{1, 2, 1, 3, 1, 2}
+		b := []int{1, 2}
+		c := []int{1, 4}
+		want := []int{1, 2, 3}
+		got := slices.MultisetDifference(a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.MultisetDifference[int]()
+		assertEqual(t, []int{}, got)
+	})
+}
+
+func TestMultisetIntersection(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []int{1, 2, 1, 3, 1, 2}
+		b := []int{2, 1, 1, 2, 2}
+		c := []int{1, 1, 1, 2, 2}
+		want := []int{1, 2, 1, 2}
+		got := slices.MultisetIntersection(a, b, c)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.MultisetIntersection[int]()
+		assertEqual(t, []int{}, got)
+	})
+}
+
+func TestMultisetUnion(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := []int{1, 2, 1}
+		b := []int{2, 3, 2, 1}
+		want := []int{1, 2, 1, 2, 3}
+		got := slices.MultisetUnion(a, b)
+		assertEqual(t, want, got)
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		got := slices.MultisetUnion[int]()
+		assertEqual(t, []int{}, got)
+	})
+}
+
+func TestIsSubMultiset(t *testing.T) {
+	assertEqual(t, true, slices.IsSubMultiset([]int{1, 2, 1}, []int{2, 1, 3, 1}))
+	assertEqual(t, false, slices.IsSubMultiset([]int{1, 2, 1}, []int{2, 1, 3}))
+}
@@ -1,71 +1,540 @@
 package slices
 
-func partition[T Ordered](s []T, low, high int) int {
-	for j := low; j < high; j++ {
-		if s[j] < s[high] {
-			s[low], s[j] = s[j], s[low]
-			low++
+import (
+	"math/bits"
+	"reflect"
+	"unsafe"
+)
+
+// insertionSortThreshold is the partition size below which the
+// introsort falls back to insertion sort.
+const insertionSortThreshold = 12
+
+// nintherThreshold is the partition size from which the pivot is
+// chosen by Tukey's ninther instead of the median of three.
+const nintherThreshold = 128
+
+// maxDepth returns the recursion depth after which the introsort
+// switches to heap sort.
+func maxDepth(n int) int {
+	return 2 * bits.Len(uint(n))
+}
+
+// sortOrdered sorts s in place, multikey sorting strings, radix
+// sorting long slices of integer and floating point types and
+// introsorting everything else. Floating point types are sorted in the
+// total order of Compare.
+func sortOrdered[T Ordered](s []T) {
+	var zero T
+	p := unsafe.Pointer(&s)
+	kind := reflect.TypeOf(zero).Kind()
+	switch kind {
+	case reflect.Float32:
+		sortFloats(*(*[]float32)(p), false)
+		return
+	case reflect.Float64:
+		sortFloats(*(*[]float64)(p), false)
+		return
+	}
+	if presorted(s) {
+		return
+	}
+	if kind == reflect.String {
+		multikeySort(*(*[]string)(p), 0, maxDepth(len(s)))
+		return
+	}
+	if len(s) >= radixThreshold && radixSort(s) {
+		return
+	}
+	quickSort(s, 0, len(s)-1, maxDepth(len(s)))
+}
+
+// presorted reports whether s is sorted after reversing it if it was
+// strictly descending.
+func presorted[T Ordered](s []T) bool {
+	i := 1
+	for i < len(s) && !(s[i] < s[i-1]) {
+		i++
+	}
+	if i == len(s) {
+		return true
+	}
+	if i > 1 {
+		return false
+	}
+	for i < len(s) && s[i] < s[i-1] {
+		i++
+	}
+	if i < len(s) {
+		return false
+	}
+	for a, b := 0, len(s)-1; a < b; a, b = a+1, b-1 {
+		s[a], s[b] = s[b], s[a]
+	}
+	return true
+}
+
+func quickSort[T Ordered](s []T, low, high, depth int) {
+	for high-low >= insertionSortThreshold {
+		if depth == 0 {
+			heapSort(s, low, high)
+			return
+		}
+		depth--
+		lt, gt := partition(s, low, high)
+		// Recurse into the smaller side and loop on the larger one so
+		// the stack depth stays logarithmic.
+		if lt-low < high-gt {
+			quickSort(s, low, lt-1, depth)
+			low = gt + 1
+		} else {
+			quickSort(s, gt+1, high, depth)
+			high = lt - 1
+		}
+	}
+	insertionSort(s, low, high)
+}
+
+// partition partitions s[low:high+1] into three parts around a pivot:
+// items less than the pivot, items equal to it and items greater than
+// it. It returns the bounds lt and gt of the middle part.
+func partition[T Ordered](s []T, low, high int) (int, int) {
+	return partitionAt(s, low, high, choosePivot(s, low, high))
+}
+
+// partitionAt partitions s[low:high+1] around the item at index p like
+// partition does.
+func partitionAt[T Ordered](s []T, low, high, p int) (int, int) {
+	s[low], s[p] = s[p], s[low]
+	pivot := s[low]
+	lt, i, gt := low, low+1, high
+	for i <= gt {
+		switch {
+		case s[i] < pivot:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case pivot < s[i]:
+			s[i], s[gt] = s[gt], s[i]
+			gt--
+		default:
+			i++
 		}
 	}
-	s[low], s[high] = s[high], s[low]
-	return low
+	return lt, gt
 }
 
-func quickSort[T Ordered](s []T, low, high int) {
-	if low < high {
-		if high-low < 12 {
-			insertionSort(s, low, high)
+func choosePivot[T Ordered](s []T, low, high int) int {
+	mid := int(uint(low+high) >> 1)
+	if high-low >= nintherThreshold {
+		step := (high - low) / 8
+		a := medianOfThree(s, low, low+step, low+2*step)
+		b := medianOfThree(s, mid-step, mid, mid+step)
+		c := medianOfThree(s, high-2*step, high-step, high)
+		return medianOfThree(s, a, b, c)
+	}
+	return medianOfThree(s, low, mid, high)
+}
+
+func medianOfThree[T Ordered](s []T, a, b, c int) int {
+	if s[b] < s[a] {
+		a, b = b, a
+	}
+	if s[c] < s[b] {
+		if s[c] < s[a] {
+			return a
+		}
+		return c
+	}
+	return b
+}
+
+func heapSort[T Ordered](s []T, low, high int) {
+	n := high - low + 1
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(s, low, i, n)
+	}
+	for i := n - 1; i > 0; i-- {
+		s[low], s[low+i] = s[low+i], s[low]
+		siftDown(s, low, 0, i)
+	}
+}
+
+func siftDown[T Ordered](s []T, first, root, n int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && s[first+child] < s[first+child+1] {
+			child++
+		}
+		if !(s[first+root] < s[first+child]) {
+			return
+		}
+		s[first+root], s[first+child] = s[first+child], s[first+root]
+		root = child
+	}
+}
+
+func insertionSort[T Ordered](s []T, low, high int) {
+	for i := low + 1; i <= high; i++ {
+		for j := i; j > low && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+func introSortFunc[T any](s []T, less func(T, T) bool) {
+	if presortedFunc(s, less) {
+		return
+	}
+	quickSortFunc(s, 0, len(s)-1, maxDepth(len(s)), less)
+}
+
+func presortedFunc[T any](s []T, less func(T, T) bool) bool {
+	i := 1
+	for i < len(s) && !less(s[i], s[i-1]) {
+		i++
+	}
+	if i == len(s) {
+		return true
+	}
+	if i > 1 {
+		return false
+	}
+	for i < len(s) && less(s[i], s[i-1]) {
+		i++
+	}
+	if i < len(s) {
+		return false
+	}
+	for a, b := 0, len(s)-1; a < b; a, b = a+1, b-1 {
+		s[a], s[b] = s[b], s[a]
+	}
+	return true
+}
+
+func quickSortFunc[T any](s []T, low, high, depth int, less func(T, T) bool) {
+	for high-low >= insertionSortThreshold {
+		if depth == 0 {
+			heapSortFunc(s, low, high, less)
+			return
+		}
+		depth--
+		lt, gt := partitionFunc(s, low, high, less)
+		if lt-low < high-gt {
+			quickSortFunc(s, low, lt-1, depth, less)
+			low = gt + 1
 		} else {
-			p := partition(s, low, high)
-			quickSort(s, low, p-1)
-			quickSort(s, p+1, high)
+			quickSortFunc(s, gt+1, high, depth, less)
+			high = lt - 1
 		}
 	}
+	insertionSortFunc(s, low, high, less)
+}
+
+func partitionFunc[T any](s []T, low, high int, less func(T, T) bool) (int, int) {
+	return partitionAtFunc(s, low, high, choosePivotFunc(s, low, high, less), less)
 }
 
-func insertionSort[T Ordered](s []T, a, b int) {
-	for i := 1; i < b-a+1; i++ {
-		j := i
-		for j > 0 {
-			if s[a+j] < s[a+j-1] {
-				s[a+j-1], s[a+j] = s[a+j], s[a+j-1]
-			}
-			j--
+func partitionAtFunc[T any](s []T, low, high, p int, less func(T, T) bool) (int, int) {
+	s[low], s[p] = s[p], s[low]
+	pivot := s[low]
+	lt, i, gt := low, low+1, high
+	for i <= gt {
+		switch {
+		case less(s[i], pivot):
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case less(pivot, s[i]):
+			s[i], s[gt] = s[gt], s[i]
+			gt--
+		default:
+			i++
 		}
 	}
+	return lt, gt
 }
 
-func partitionFunc[T any](s []T, low, high int, less func(T, T) bool) int {
-	for j := low; j < high; j++ {
-		if less(s[j], s[high]) {
-			s[low], s[j] = s[j], s[low]
-			low++
+func choosePivotFunc[T any](s []T, low, high int, less func(T, T) bool) int {
+	mid := int(uint(low+high) >> 1)
+	if high-low >= nintherThreshold {
+		step := (high - low) / 8
+		a := medianOfThreeFunc(s, low, low+step, low+2*step, less)
+		b := medianOfThreeFunc(s, mid-step, mid, mid+step, less)
+		c := medianOfThreeFunc(s, high-2*step, high-step, high, less)
+		return medianOfThreeFunc(s, a, b, c, less)
+	}
+	return medianOfThreeFunc(s, low, mid, high, less)
+}
+
+func medianOfThreeFunc[T any](s []T, a, b, c int, less func(T, T) bool) int {
+	if less(s[b], s[a]) {
+		a, b = b, a
+	}
+	if less(s[c], s[b]) {
+		if less(s[c], s[a]) {
+			return a
 		}
+		return c
+	}
+	return b
+}
+
+func heapSortFunc[T any](s []T, low, high int, less func(T, T) bool) {
+	n := high - low + 1
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDownFunc(s, low, i, n, less)
+	}
+	for i := n - 1; i > 0; i-- {
+		s[low], s[low+i] = s[low+i], s[low]
+		siftDownFunc(s, low, 0, i, less)
 	}
-	s[low], s[high] = s[high], s[low]
-	return low
 }
 
-func quickSortFunc[T any](s []T, low, high int, less func(T, T) bool) {
-	if low < high {
-		if high-low < 12 {
-			insertionSortFunc(s, low, high, less)
+func siftDownFunc[T any](s []T, first, root, n int, less func(T, T) bool) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && less(s[first+child], s[first+child+1]) {
+			child++
+		}
+		if !less(s[first+root], s[first+child]) {
+			return
+		}
+		s[first+root], s[first+child] = s[first+child], s[first+root]
+		root = child
+	}
+}
+
+func insertionSortFunc[T any](s []T, low, high int, less func(T, T) bool) {
+	for i := low + 1; i <= high; i++ {
+		for j := i; j > low && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// stableBlockSize is the size of the blocks that the merge sort
+// insertion sorts before merging them.
+const stableBlockSize = 20
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// sortStableOrdered sorts s in place like mergeSort, but sorts
+// floating point types in the total order of Compare.
+func sortStableOrdered[T Ordered](s []T) {
+	var zero T
+	p := unsafe.Pointer(&s)
+	switch reflect.TypeOf(zero).Kind() {
+	case reflect.Float32:
+		sortFloats(*(*[]float32)(p), true)
+	case reflect.Float64:
+		sortFloats(*(*[]float64)(p), true)
+	default:
+		mergeSort(s)
+	}
+}
+
+// mergeSort sorts s in place, keeping equal items in their original
+// order.
+func mergeSort[T Ordered](s []T) {
+	n := len(s)
+	for lo := 0; lo < n; lo += stableBlockSize {
+		insertionSort(s, lo, minInt(lo+stableBlockSize, n)-1)
+	}
+	if n <= stableBlockSize {
+		return
+	}
+	buf := make([]T, 0, n/2+1)
+	for width := stableBlockSize; width < n; width *= 2 {
+		for lo := 0; lo+width < n; lo += 2 * width {
+			buf = merge(s[lo:minInt(lo+2*width, n)], width, buf)
+		}
+	}
+}
+
+// merge merges the sorted runs s[:mid] and s[mid:] using buf as
+// scratch space and returns the possibly grown buffer. Items of the
+// runs that are already in their final position are found by binary
+// search and left untouched.
+func merge[T Ordered](s []T, mid int, buf []T) []T {
+	if !(s[mid] < s[mid-1]) {
+		return buf
+	}
+	lo := upperBound(s[:mid], s[mid])
+	hi := mid + lowerBound(s[mid:], s[mid-1])
+	buf = append(buf[:0], s[lo:mid]...)
+	i, j, k := 0, mid, lo
+	for i < len(buf) && j < hi {
+		if s[j] < buf[i] {
+			s[k] = s[j]
+			j++
+		} else {
+			s[k] = buf[i]
+			i++
+		}
+		k++
+	}
+	copy(s[k:], buf[i:])
+	return buf
+}
+
+// lowerBound returns the index of the first item in the sorted slice
+// s that is not less than x.
+func lowerBound[T Ordered](s []T, x T) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if s[m] < x {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo
+}
+
+// upperBound returns the index of the first item in the sorted slice
+// s that is greater than x.
+func upperBound[T Ordered](s []T, x T) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if x < s[m] {
+			hi = m
+		} else {
+			lo = m + 1
+		}
+	}
+	return lo
+}
+
+func mergeSortFunc[T any](s []T, less func(T, T) bool) {
+	n := len(s)
+	for lo := 0; lo < n; lo += stableBlockSize {
+		insertionSortFunc(s, lo, minInt(lo+stableBlockSize, n)-1, less)
+	}
+	if n <= stableBlockSize {
+		return
+	}
+	buf := make([]T, 0, n/2+1)
+	for width := stableBlockSize; width < n; width *= 2 {
+		for lo := 0; lo+width < n; lo += 2 * width {
+			buf = mergeFunc(s[lo:minInt(lo+2*width, n)], width, buf, less)
+		}
+	}
+}
+
+func mergeFunc[T any](s []T, mid int, buf []T, less func(T, T) bool) []T {
+	if !less(s[mid], s[mid-1]) {
+		return buf
+	}
+	lo := upperBoundFunc(s[:mid], s[mid], less)
+	hi := mid + lowerBoundFunc(s[mid:], s[mid-1], less)
+	buf = append(buf[:0], s[lo:mid]...)
+	i, j, k := 0, mid, lo
+	for i < len(buf) && j < hi {
+		if less(s[j], buf[i]) {
+			s[k] = s[j]
+			j++
 		} else {
-			p := partitionFunc(s, low, high, less)
-			quickSortFunc(s, low, p-1, less)
-			quickSortFunc(s, p+1, high, less)
+			s[k] = buf[i]
+			i++
 		}
+		k++
 	}
+	copy(s[k:], buf[i:])
+	return buf
 }
 
-func insertionSortFunc[T any](s []T, a, b int, less func(T, T) bool) {
-	for i := 1; i < b-a+1; i++ {
-		j := i
-		for j > 0 {
-			if less(s[a+j], s[a+j-1]) {
-				s[a+j-1], s[a+j] = s[a+j], s[a+j-1]
-			}
-			j--
+func lowerBoundFunc[T any](s []T, x T, less func(T, T) bool) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if less(s[m], x) {
+			lo = m + 1
+		} else {
+			hi = m
 		}
 	}
+	return lo
+}
+
+func upperBoundFunc[T any](s []T, x T, less func(T, T) bool) int {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if less(x, s[m]) {
+			hi = m
+		} else {
+			lo = m + 1
+		}
+	}
+	return lo
+}
+
+// keyed pairs an item with its precomputed sort key.
+type keyed[T any, K Ordered] struct {
+	key  K
+	item T
+}
+
+// sortByKey sorts s by the keys returned by key, calling key exactly
+// once per item, and returns the sorted items in a new slice.
+func sortByKey[T any, K Ordered](s []T, key func(T) K, stable bool, less func(a, b K) bool) []T {
+	d := make([]keyed[T, K], len(s))
+	for i := range s {
+		d[i] = keyed[T, K]{key: key(s[i]), item: s[i]}
+	}
+	lessKeyed := func(a, b keyed[T, K]) bool {
+		return less(a.key, b.key)
+	}
+	if stable {
+		mergeSortFunc(d, lessKeyed)
+	} else {
+		introSortFunc(d, lessKeyed)
+	}
+	res := make([]T, len(d))
+	for i := range d {
+		res[i] = d[i].item
+	}
+	return res
+}
+
+// ascending returns a less func that orders items in ascending order
+// in the total order of Compare. The floating point path is chosen
+// once, so for other types the less func is just <.
+func ascending[K Ordered]() func(a, b K) bool {
+	if isFloat[K]() {
+		return func(a, b K) bool {
+			return Compare(a, b) < 0
+		}
+	}
+	return func(a, b K) bool {
+		return a < b
+	}
+}
+
+// descending returns a less func that orders items in descending
+// order in the total order of Compare, choosing the floating point
+// path once like ascending.
+func descending[K Ordered]() func(a, b K) bool {
+	if isFloat[K]() {
+		return func(a, b K) bool {
+			return Compare(b, a) < 0
+		}
+	}
+	return func(a, b K) bool {
+		return b < a
+	}
 }
@@ -0,0 +1,120 @@
+package slices
+
+// SortedSlice is a slice that keeps its items sorted in ascending
+// order as they are inserted and deleted. Items that are equal keep
+// the order in which they were inserted. A sorted slice must be
+// created with NewSortedSlice or NewSortedSliceFunc; the zero value
+// has no order and is not usable.
+type SortedSlice[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewSortedSlice creates a new sorted slice that contains the given
+// items and returns it.
+func NewSortedSlice[T Ordered](items ...T) *SortedSlice[T] {
+	return NewSortedSliceFunc(ascending[T](), items...)
+}
+
+// NewSortedSliceFunc creates a new sorted slice that contains the
+// given items, ordered by the given less func, and returns it.
+func NewSortedSliceFunc[T any](less func(a, b T) bool, items ...T) *SortedSlice[T] {
+	if debug {
+		mustCheckLess(items, less)
+	}
+	c := Clone(items)
+	mergeSortFunc(c, less)
+	return &SortedSlice[T]{items: c, less: less}
+}
+
+// Insert inserts the given items into the sorted slice, each after any
+// equal items already in it.
+func (s *SortedSlice[T]) Insert(items ...T) {
+	for _, item := range items {
+		i := upperBoundFunc(s.items, item, s.less)
+		var zero T
+		s.items = append(s.items, zero)
+		copy(s.items[i+1:], s.items[i:])
+		s.items[i] = item
+	}
+}
+
+// Delete deletes the first item equal to the given item from the
+// sorted slice and reports whether there was such an item.
+func (s *SortedSlice[T]) Delete(item T) bool {
+	i := lowerBoundFunc(s.items, item, s.less)
+	if i == len(s.items) || s.less(item, s.items[i]) {
+		return false
+	}
+	copy(s.items[i:], s.items[i+1:])
+	var zero T
+	s.items[len(s.items)-1] = zero
+	s.items = s.items[:len(s.items)-1]
+	return true
+}
+
+// Len returns the number of items in the sorted slice.
+func (s *SortedSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// At returns the item at the given index of the sorted slice.
+func (s *SortedSlice[T]) At(i int) T {
+	return s.items[i]
+}
+
+// Rank returns the number of items in the sorted slice that are less
+// than the given item.
+func (s *SortedSlice[T]) Rank(item T) int {
+	return lowerBoundFunc(s.items, item, s.less)
+}
+
+// Range creates a new slice that contains the items of the sorted
+// slice that are not less than lo and less than hi and returns it.
+func (s *SortedSlice[T]) Range(lo, hi T) []T {
+	i := lowerBoundFunc(s.items, lo, s.less)
+	j := i + lowerBoundFunc(s.items[i:], hi, s.less)
+	return Clone(s.items[i:j])
+}
+
+// Floor returns the greatest item in the sorted slice that is not
+// greater than the given item and reports whether there is one.
+func (s *SortedSlice[T]) Floor(item T) (T, bool) {
+	i := upperBoundFunc(s.items, item, s.less)
+	if i == 0 {
+		var t T
+		return t, false
+	}
+	return s.items[i-1], true
+}
+
+// Ceiling returns the least item in the sorted slice that is not less
+// than the given item and reports whether there is one.
+func (s *SortedSlice[T]) Ceiling(item T) (T, bool) {
+	i := lowerBoundFunc(s.items, item, s.less)
+	if i == len(s.items) {
+		var t T
+		return t, false
+	}
+	return s.items[i], true
+}
+
+// Merge inserts all the items of the given sorted slice, which must be
+// ordered the same way, into the sorted slice in linear time. The
+// given sorted slice is not changed.
+func (s *SortedSlice[T]) Merge(other *SortedSlice[T]) {
+	if len(other.items) == 0 {
+		return
+	}
+	mid := len(s.items)
+	s.items = append(s.items, other.items...)
+	if mid > 0 {
+		mergeFunc(s.items, mid, nil, s.less)
+	}
+}
+
+// Slice creates a new slice that contains the items of the sorted
+// slice in ascending order and returns it.
+func (s *SortedSlice[T]) Slice() []T {
+	return Clone(s.items)
+}
@@ -0,0 +1,114 @@
+package slices_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func TestSortedSlice(t *testing.T) {
+	t.Run("new", func(t *testing.T) {
+		s := slices.NewSortedSlice(5, 1, 3)
+		assertEqual(t, []int{1, 3, 5}, s.Slice())
+		assertEqual(t, 3, s.Len())
+	})
+	t.Run("insert", func(t *testing.T) {
+		s := slices.NewSortedSlice[int]()
+		var want []int
+		for i := 0; i < 200; i++ {
+			n := rand.Intn(50)
+			s.Insert(n)
+			want = append(want, n)
+		}
+		sort.Ints(want)
+		assertEqual(t, want, s.Slice())
+	})
+	t.Run("insert stable", func(t *testing.T) {
+		s := slices.NewSortedSliceFunc(slices.By(recordID), record{2, "a"}, record{1, "b"})
+		s.Insert(record{2, "c"}, record{1, "d"})
+		want := []record{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}
+		assertEqual(t, want, s.Slice())
+	})
+	t.Run("delete", func(t *testing.T) {
+		s := slices.NewSortedSlice(1, 2, 2, 3)
+		assertEqual(t, true, s.Delete(2))
+		assertEqual(t, []int{1, 2, 3}, s.Slice())
+		assertEqual(t, false, s.Delete(4))
+		assertEqual(t, false, s.Delete(0))
+		assertEqual(t, true, s.Delete(3))
+		assertEqual(t, []int{1, 2}, s.Slice())
+	})
+}
+
+func TestSortedSliceAt(t *testing.T) {
+	s := slices.NewSortedSlice("c", "a", "b")
+	assertEqual(t, "a", s.At(0))
+	assertEqual(t, "c", s.At(2))
+}
+
+func TestSortedSliceRank(t *testing.T) {
+	s := slices.NewSortedSlice(10, 20, 20, 30)
+	assertEqual(t, 0, s.Rank(5))
+	assertEqual(t, 1, s.Rank(20))
+	assertEqual(t, 3, s.Rank(25))
+	assertEqual(t, 4, s.Rank(40))
+}
+
+func TestSortedSliceRange(t *testing.T) {
+	s := slices.NewSortedSlice(10, 20, 20, 30, 40)
+	assertEqual(t, []int{20, 20, 30}, s.Range(15, 40))
+	assertEqual(t, []int{}, s.Range(21, 30))
+	assertEqual(t, []int{10, 20, 20, 30, 40}, s.Range(0, 50))
+}
+
+func TestSortedSliceFloor(t *testing.T) {
+	s := slices.NewSortedSlice(10, 20, 30)
+	t.Run("found", func(t *testing.T) {
+		got, ok := s.Floor(25)
+		assertEqual(t, 20, got)
+		assertEqual(t, true, ok)
+		got, ok = s.Floor(30)
+		assertEqual(t, 30, got)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, ok := s.Floor(5)
+		assertEqual(t, 0, got)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestSortedSliceCeiling(t *testing.T) {
+	s := slices.NewSortedSlice(10, 20, 30)
+	t.Run("found", func(t *testing.T) {
+		got, ok := s.Ceiling(15)
+		assertEqual(t, 20, got)
+		assertEqual(t, true, ok)
+		got, ok = s.Ceiling(10)
+		assertEqual(t, 10, got)
+		assertEqual(t, true, ok)
+	})
+	t.Run("not found", func(t *testing.T) {
+		got, ok := s.Ceiling(35)
+		assertEqual(t, 0, got)
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestSortedSliceMerge(t *testing.T) {
+	t.Run("common", func(t *testing.T) {
+		a := slices.NewSortedSlice(1, 4, 6)
+		b := slices.NewSortedSlice(2, 4, 7)
+		a.Merge(b)
+		assertEqual(t, []int{1, 2, 4, 4, 6, 7}, a.Slice())
+		assertEqual(t, []int{2, 4, 7}, b.Slice())
+	})
+	t.Run("empty", func(t *testing.T) {
+		a := slices.NewSortedSlice[int]()
+		a.Merge(slices.NewSortedSlice(2, 1))
+		a.Merge(slices.NewSortedSlice[int]())
+		assertEqual(t, []int{1, 2}, a.Slice())
+	})
+}
@@ -0,0 +1,238 @@
+package slices
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// SortStringsFold creates a new slice that is sorted in ascending
+// order, comparing the strings rune by rune after folding them to
+// lower case, and returns it. Strings that are equal apart from case
+// are sorted by their bytes so the result is deterministic. The given
+// slice is not changed.
+func SortStringsFold[T ~string](s []T) []T {
+	c := Clone(s)
+	introSortFunc(c, func(a, b T) bool {
+		if cmp := compareFold(string(a), string(b)); cmp != 0 {
+			return cmp < 0
+		}
+		return a < b
+	})
+	return c
+}
+
+// SortNatural creates a new slice that is sorted in ascending natural
+// order, as defined by NaturalLess, and returns it. The given slice is
+// not changed.
+func SortNatural[T ~string](s []T) []T {
+	c := Clone(s)
+	introSortFunc(c, func(a, b T) bool {
+		return NaturalCompare(string(a), string(b)) < 0
+	})
+	return c
+}
+
+// NaturalLess reports whether a comes before b in natural order, in
+// which runs of digits are compared by their numeric value and
+// everything else is compared case insensitively, so file2 comes
+// before file10 and v1.9 before v1.10. Strings that are equal in this
+// sense are ordered by their first difference: fewer leading zeros
+// come first, then upper case before lower case.
+func NaturalLess(a, b string) bool {
+	return NaturalCompare(a, b) < 0
+}
+
+// NaturalCompare returns -1 if a comes before b in natural order, 0 if
+// a equals b and +1 if a comes after b. See NaturalLess for the
+// definition of natural order.
+func NaturalCompare(a, b string) int {
+	tie := 0
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			var da, db string
+			da, a = digitRun(a)
+			db, b = digitRun(b)
+			na, nb := trimZeros(da), trimZeros(db)
+			if len(na) != len(nb) {
+				return Compare(len(na), len(nb))
+			}
+			if na != nb {
+				return Compare(na, nb)
+			}
+			if tie == 0 {
+				tie = Compare(len(da), len(db))
+			}
+			continue
+		}
+		ra, size := utf8.DecodeRuneInString(a)
+		a = a[size:]
+		rb, size := utf8.DecodeRuneInString(b)
+		b = b[size:]
+		if ra == rb {
+			continue
+		}
+		if la, lb := unicode.ToLower(ra), unicode.ToLower(rb); la != lb {
+			return Compare(la, lb)
+		}
+		if tie == 0 {
+			tie = Compare(ra, rb)
+		}
+	}
+	switch {
+	case a != "":
+		return 1
+	case b != "":
+		return -1
+	}
+	return tie
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digitRun splits s after its leading run of digits.
+func digitRun(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+func trimZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
+// multikeySort sorts s in place with a three-way radix quicksort,
+// which partitions the strings by one byte at a time so that shared
+// prefixes are only examined once. All strings in s must share their
+// first d bytes. It loops on the largest of the three parts and only
+// recurses into the other two, which are at most half as long, so the
+// stack stays shallow however long the shared prefixes are.
+func multikeySort(s []string, d, depth int) {
+	for len(s) > insertionSortThreshold {
+		if depth == 0 {
+			quickSort(s, 0, len(s)-1, maxDepth(len(s)))
+			return
+		}
+		lt, gt := partitionByte(s, d)
+		if lt == 0 && gt == len(s)-1 {
+			// All strings have the same byte at d, so skip the whole
+			// prefix they share instead of one byte at a time.
+			if byteAt(s[0], d) < 0 {
+				return
+			}
+			d += sharedPrefix(s, d)
+			continue
+		}
+		depth--
+		left, mid, right := s[:lt], s[lt:gt+1], s[gt+1:]
+		if byteAt(mid[0], d) < 0 {
+			mid = nil
+		}
+		switch {
+		case len(mid) >= len(left) && len(mid) >= len(right):
+			multikeySort(left, d, depth)
+			multikeySort(right, d, depth)
+			s, d, depth = mid, d+1, maxDepth(len(mid))
+		case len(left) >= len(right):
+			multikeySort(mid, d+1, maxDepth(len(mid)))
+			multikeySort(right, d, depth)
+			s = left
+		default:
+			multikeySort(left, d, depth)
+			multikeySort(mid, d+1, maxDepth(len(mid)))
+			s = right
+		}
+	}
+	insertionSortFrom(s, d)
+}
+
+// sharedPrefix returns the length of the prefix that all strings in s
+// share after their first d bytes.
+func sharedPrefix(s []string, d int) int {
+	p := s[0][d:]
+	for _, str := range s[1:] {
+		p = p[:commonPrefix(p, str[d:])]
+	}
+	return len(p)
+}
+
+// commonPrefix returns the length of the longest common prefix of a
+// and b. It compares blocks of bytes at once before the single bytes.
+func commonPrefix(a, b string) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	i := 0
+	for i+64 <= n && a[i:i+64] == b[i:i+64] {
+		i += 64
+	}
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// partitionByte partitions s into three parts around a pivot by the
+// byte at index d: strings whose byte is less than the pivot's,
+// strings whose byte is equal to it and strings whose byte is greater
+// than it. It returns the bounds lt and gt of the middle part.
+func partitionByte(s []string, d int) (int, int) {
+	high := len(s) - 1
+	p := medianOfThreeBytes(s, d, 0, high/2, high)
+	s[0], s[p] = s[p], s[0]
+	pivot := byteAt(s[0], d)
+	lt, i, gt := 0, 1, high
+	for i <= gt {
+		b := byteAt(s[i], d)
+		switch {
+		case b < pivot:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case b > pivot:
+			s[i], s[gt] = s[gt], s[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+func medianOfThreeBytes(s []string, d, a, b, c int) int {
+	if byteAt(s[b], d) < byteAt(s[a], d) {
+		a, b = b, a
+	}
+	if byteAt(s[c], d) < byteAt(s[b], d) {
+		if byteAt(s[c], d) < byteAt(s[a], d) {
+			return a
+		}
+		return c
+	}
+	return b
+}
+
+// byteAt returns the byte of str at index d, or -1 if str is shorter.
+func byteAt(str string, d int) int {
+	if d < len(str) {
+		return int(str[d])
+	}
+	return -1
+}
+
+// insertionSortFrom insertion sorts s, which must share their first d
+// bytes, comparing only the bytes after them.
+func insertionSortFrom(s []string, d int) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j][d:] < s[j-1][d:]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
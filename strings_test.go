@@ -0,0 +1,109 @@
+package slices_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/twharmon/slices"
+)
+
+func TestSortStrings(t *testing.T) {
+	t.Run("words", func(t *testing.T) {
+		want := slices.Clone(unsortedStringSlice)
+		sort.Strings(want)
+		assertEqual(t, want, slices.Sort(unsortedStringSlice))
+	})
+	t.Run("shared prefixes", func(t *testing.T) {
+		s := make([]string, 5000)
+		for i := range s {
+			var b strings.Builder
+			b.WriteString("/api/v1/users")
+			for j := rand.Intn(6); j > 0; j-- {
+				b.WriteString([]string{"/", "a", "b", "/x", ""}[rand.Intn(5)])
+			}
+			s[i] = b.String()
+		}
+		want := slices.Clone(s)
+		sort.Strings(want)
+		assertEqual(t, want, slices.Sort(s))
+	})
+	t.Run("long shared prefix", func(t *testing.T) {
+		// The strings are prefixes of one long string, so they share
+		// its first 8 MiB without taking more memory.
+		long := strings.Repeat("a", 8<<20) + "abcdefghijklmnopqrstuvwxyz"
+		s := make([]string, 100)
+		for i := range s {
+			s[i] = long[:len(long)-rand.Intn(52)]
+		}
+		want := slices.Clone(s)
+		sort.Strings(want)
+		assertEqual(t, want, slices.Sort(s))
+	})
+	t.Run("binary", func(t *testing.T) {
+		s := make([]string, 1000)
+		for i := range s {
+			b := make([]byte, rand.Intn(4))
+			for j := range b {
+				b[j] = []byte{0, 1, 255}[rand.Intn(3)]
+			}
+			s[i] = string(b)
+		}
+		want := slices.Clone(s)
+		sort.Strings(want)
+		assertEqual(t, want, slices.Sort(s))
+	})
+	t.Run("named", func(t *testing.T) {
+		type path string
+		assertEqual(t, []path{"", "/a", "/b", "/b/c"}, slices.Sort([]path{"/b/c", "/a", "", "/b"}))
+	})
+}
+
+func TestSortStringsFold(t *testing.T) {
+	s := []string{"banana", "Apple", "apple", "Ärger", "cherry", "APPLE", "äpfel"}
+	want := []string{"APPLE", "Apple", "apple", "banana", "cherry", "äpfel", "Ärger"}
+	assertEqual(t, want, slices.SortStringsFold(s))
+}
+
+func TestSortNatural(t *testing.T) {
+	t.Run("files", func(t *testing.T) {
+		s := []string{"file10.txt", "file2.txt", "File1.txt", "file1.txt", "file01.txt", "file"}
+		want := []string{"file", "File1.txt", "file1.txt", "file01.txt", "file2.txt", "file10.txt"}
+		assertEqual(t, want, slices.SortNatural(s))
+	})
+	t.Run("versions", func(t *testing.T) {
+		s := []string{"v1.10", "v1.9", "v1.9.1", "v2.0", "v1.0"}
+		want := []string{"v1.0", "v1.9", "v1.9.1", "v1.10", "v2.0"}
+		assertEqual(t, want, slices.SortNatural(s))
+	})
+	t.Run("large numbers", func(t *testing.T) {
+		s := []string{"x123456789012345678901234567890", "x99999999999999999999", "x0"}
+		want := []string{"x0", "x99999999999999999999", "x123456789012345678901234567890"}
+		assertEqual(t, want, slices.SortNatural(s))
+	})
+	t.Run("deterministic", func(t *testing.T) {
+		s := []string{"a01b", "a1B", "A1b", "a1b", "a001b"}
+		want := []string{"A1b", "a1B", "a1b", "a01b", "a001b"}
+		for i := 0; i < 10; i++ {
+			rand.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+			assertEqual(t, want, slices.SortNatural(s))
+		}
+	})
+}
+
+func TestNaturalLess(t *testing.T) {
+	s := []string{"img12", "img2", "img10", "IMG3"}
+	assertEqual(t, []string{"img2", "IMG3", "img10", "img12"}, slices.SortFunc(s, slices.NaturalLess))
+	assertEqual(t, "img2", slices.MinFunc(s, slices.NaturalLess))
+	assertEqual(t, "img12", slices.MaxFunc(s, slices.NaturalLess))
+	assertEqual(t, false, slices.NaturalLess("a", "a"))
+}
+
+func TestNaturalCompare(t *testing.T) {
+	assertEqual(t, -1, slices.NaturalCompare("a2", "a10"))
+	assertEqual(t, 1, slices.NaturalCompare("a10", "a2"))
+	assertEqual(t, 0, slices.NaturalCompare("a10", "a10"))
+	assertEqual(t, -1, slices.NaturalCompare("a", "a0"))
+	assertEqual(t, 1, slices.NaturalCompare("b", "A"))
+}